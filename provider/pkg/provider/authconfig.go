@@ -0,0 +1,361 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/LuxChanLu/pulumi-supabase/pkg/provider/client"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+// maxJwtExpiry is the longest JWT expiry accepted by the API, one week
+const maxJwtExpiry = 604800
+
+// authExternalProviders are the OAuth providers supported by Supabase Auth
+var authExternalProviders = []string{"apple", "azure", "bitbucket", "discord", "facebook", "figma", "github", "gitlab", "google", "kakao", "keycloak", "linkedin", "notion", "slack", "spotify", "twitch", "twitter", "workos", "zoom"}
+
+// authSmsProviders are the SMS providers supported by Supabase Auth
+var authSmsProviders = []string{"twilio", "twilio_verify", "messagebird", "textlocal", "vonage"}
+
+// authConfig are the inputs and the outputs of the auth configuration of a project, unset settings are left as is
+type authConfig struct {
+	ProjectId              string                          `json:"projectId"`
+	SiteUrl                *string                         `json:"siteUrl,omitempty"`
+	AdditionalRedirectUrls *[]string                       `json:"additionalRedirectUrls,omitempty"`
+	JwtExpiry              *int                            `json:"jwtExpiry,omitempty"`
+	DisableSignup          *bool                           `json:"disableSignup,omitempty"`
+	Email                  *authEmailConfig                `json:"email,omitempty"`
+	Sms                    *authSmsConfig                  `json:"sms,omitempty"`
+	ExternalProviders      map[string]authExternalProvider `json:"externalProviders,omitempty"`
+}
+
+type authEmailConfig struct {
+	Enabled     *bool           `json:"enabled,omitempty"`
+	Autoconfirm *bool           `json:"autoconfirm,omitempty"`
+	Smtp        *authSmtpConfig `json:"smtp,omitempty"`
+}
+
+type authSmtpConfig struct {
+	Host       *string `json:"host,omitempty"`
+	Port       *int    `json:"port,omitempty"`
+	User       *string `json:"user,omitempty"`
+	Pass       *string `json:"pass,omitempty"`
+	AdminEmail *string `json:"adminEmail,omitempty"`
+	SenderName *string `json:"senderName,omitempty"`
+}
+
+type authSmsConfig struct {
+	Enabled     *bool             `json:"enabled,omitempty"`
+	Autoconfirm *bool             `json:"autoconfirm,omitempty"`
+	Provider    *string           `json:"provider,omitempty"`
+	Twilio      *authTwilioConfig `json:"twilio,omitempty"`
+}
+
+type authTwilioConfig struct {
+	AccountSid        *string `json:"accountSid,omitempty"`
+	AuthToken         *string `json:"authToken,omitempty"`
+	MessageServiceSid *string `json:"messageServiceSid,omitempty"`
+}
+
+type authExternalProvider struct {
+	Enabled  *bool   `json:"enabled,omitempty"`
+	ClientId *string `json:"clientId,omitempty"`
+	Secret   *string `json:"secret,omitempty"`
+	Url      *string `json:"url,omitempty"`
+}
+
+func checkAuthConfig(inputs resource.PropertyMap) []*pulumirpc.CheckFailure {
+	config := authConfig{}
+	if err := propertiesMapToStruct(inputs, &config); err != nil {
+		return []*pulumirpc.CheckFailure{checkFailure("", "%s", err)}
+	}
+	known := func(key resource.PropertyKey) bool {
+		return inputs.HasValue(key) && !inputs[key].ContainsUnknowns()
+	}
+	failures := []*pulumirpc.CheckFailure{}
+	if known("siteUrl") && config.SiteUrl != nil && !isAbsoluteUrl(*config.SiteUrl) {
+		failures = append(failures, checkFailure("siteUrl", "'%s' is not an absolute URL", *config.SiteUrl))
+	}
+	if known("additionalRedirectUrls") && config.AdditionalRedirectUrls != nil {
+		for i, redirectUrl := range *config.AdditionalRedirectUrls {
+			if strings.TrimSpace(redirectUrl) == "" || strings.Contains(redirectUrl, ",") {
+				failures = append(failures, checkFailure(resource.PropertyPath{"additionalRedirectUrls", i}, "redirect URLs must not be empty nor contain a comma"))
+			}
+		}
+	}
+	if known("jwtExpiry") && config.JwtExpiry != nil && (*config.JwtExpiry <= 0 || *config.JwtExpiry > maxJwtExpiry) {
+		failures = append(failures, checkFailure("jwtExpiry", "jwtExpiry must be between 1 and %d seconds", maxJwtExpiry))
+	}
+	if known("email") && config.Email != nil && config.Email.Smtp != nil && config.Email.Smtp.Port != nil && (*config.Email.Smtp.Port <= 0 || *config.Email.Smtp.Port > 65535) {
+		failures = append(failures, checkFailure(resource.PropertyPath{"email", "smtp", "port"}, "%d is not a valid port", *config.Email.Smtp.Port))
+	}
+	if known("sms") && config.Sms != nil && config.Sms.Provider != nil && !containsString(authSmsProviders, *config.Sms.Provider) {
+		failures = append(failures, checkFailure(resource.PropertyPath{"sms", "provider"}, "'%s' is not a supported SMS provider, expected one of: %s", *config.Sms.Provider, strings.Join(authSmsProviders, ", ")))
+	}
+	if known("externalProviders") {
+		names := []string{}
+		for name := range config.ExternalProviders {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if !containsString(authExternalProviders, name) {
+				failures = append(failures, checkFailure(resource.PropertyPath{"externalProviders", name}, "'%s' is not a supported provider, expected one of: %s", name, strings.Join(authExternalProviders, ", ")))
+			}
+		}
+	}
+	return failures
+}
+
+func (p *supabaseProvider) createAuthConfig(ctx context.Context, inputs resource.PropertyMap, preview bool) (string, *authConfig, error) {
+	config := authConfig{}
+	if err := propertiesMapToStruct(inputs, &config); err != nil {
+		return "", nil, err
+	}
+	if preview {
+		return "", &config, nil
+	}
+	if err := p.applyAuthConfig(ctx, config); err != nil {
+		return "", nil, err
+	}
+	return config.ProjectId, &config, nil
+}
+
+func (p *supabaseProvider) readAuthConfig(ctx context.Context, projectId string, state resource.PropertyMap) (string, *authConfig, error) {
+	known := authConfig{}
+	if err := propertiesMapToStruct(state, &known); err != nil {
+		return "", nil, err
+	}
+	config, err := p.supabase.GetAuthConfigWithResponse(ctx, projectId)
+	if err := checkForSupabaseError(config, err); err != nil {
+		if isNotFound(err) {
+			return "", nil, nil
+		}
+		return "", nil, err
+	}
+	if config.JSON200 == nil {
+		return "", nil, errUnexpectedResponse(config)
+	}
+	// The API returns every auth setting, those the program leaves out stay unmanaged unless the config is imported
+	importing := len(state) == 0
+	return projectId, authConfigFromAPI(projectId, *config.JSON200, known, importing), nil
+}
+
+func (p *supabaseProvider) updateAuthConfig(ctx context.Context, inputs resource.PropertyMap, preview bool) (*authConfig, error) {
+	config := authConfig{}
+	if err := propertiesMapToStruct(inputs, &config); err != nil {
+		return nil, err
+	}
+	if !preview {
+		if err := p.applyAuthConfig(ctx, config); err != nil {
+			return nil, err
+		}
+	}
+	return &config, nil
+}
+
+// applyAuthConfig sends every setting of the resource, the other settings of the project are not changed
+func (p *supabaseProvider) applyAuthConfig(ctx context.Context, config authConfig) error {
+	body := config.toAPI()
+	if len(body) == 0 {
+		return nil
+	}
+	res, err := p.supabase.UpdateAuthConfigWithResponse(withRetrySafe(ctx), config.ProjectId, body)
+	return checkForSupabaseError(res, err)
+}
+
+func (c authConfig) toAPI() client.UpdateAuthConfigJSONRequestBody {
+	body := client.UpdateAuthConfigJSONRequestBody{}
+	set := func(key string, value interface{}) {
+		switch value := value.(type) {
+		case *string:
+			if value != nil {
+				body[key] = *value
+			}
+		case *bool:
+			if value != nil {
+				body[key] = *value
+			}
+		case *int:
+			if value != nil {
+				body[key] = *value
+			}
+		}
+	}
+	set("site_url", c.SiteUrl)
+	if c.AdditionalRedirectUrls != nil {
+		body["uri_allow_list"] = strings.Join(*c.AdditionalRedirectUrls, ",")
+	}
+	set("jwt_exp", c.JwtExpiry)
+	set("disable_signup", c.DisableSignup)
+	if c.Email != nil {
+		set("external_email_enabled", c.Email.Enabled)
+		set("mailer_autoconfirm", c.Email.Autoconfirm)
+		if smtp := c.Email.Smtp; smtp != nil {
+			set("smtp_host", smtp.Host)
+			if smtp.Port != nil {
+				body["smtp_port"] = strconv.Itoa(*smtp.Port)
+			}
+			set("smtp_user", smtp.User)
+			set("smtp_pass", smtp.Pass)
+			set("smtp_admin_email", smtp.AdminEmail)
+			set("smtp_sender_name", smtp.SenderName)
+		}
+	}
+	if c.Sms != nil {
+		set("external_phone_enabled", c.Sms.Enabled)
+		set("sms_autoconfirm", c.Sms.Autoconfirm)
+		set("sms_provider", c.Sms.Provider)
+		if twilio := c.Sms.Twilio; twilio != nil {
+			set("sms_twilio_account_sid", twilio.AccountSid)
+			set("sms_twilio_auth_token", twilio.AuthToken)
+			set("sms_twilio_message_service_sid", twilio.MessageServiceSid)
+		}
+	}
+	for name, provider := range c.ExternalProviders {
+		set("external_"+name+"_enabled", provider.Enabled)
+		set("external_"+name+"_client_id", provider.ClientId)
+		set("external_"+name+"_secret", provider.Secret)
+		set("external_"+name+"_url", provider.Url)
+	}
+	return body
+}
+
+// authConfigFromAPI maps the auth config of the API to the settings known by the resource, or to every setting
+// the API returns when all is set
+func authConfigFromAPI(projectId string, api client.AuthConfigResponse, known authConfig, all bool) *authConfig {
+	r := authConfigReader{api: api, all: all}
+	config := &authConfig{
+		ProjectId:     projectId,
+		SiteUrl:       r.string("site_url", known.SiteUrl),
+		JwtExpiry:     r.int("jwt_exp", known.JwtExpiry),
+		DisableSignup: r.bool("disable_signup", known.DisableSignup),
+	}
+	if uris := r.string("uri_allow_list", nil); uris != nil && (all || known.AdditionalRedirectUrls != nil) {
+		redirectUrls := []string{}
+		for _, uri := range strings.Split(*uris, ",") {
+			if uri = strings.TrimSpace(uri); uri != "" {
+				redirectUrls = append(redirectUrls, uri)
+			}
+		}
+		config.AdditionalRedirectUrls = &redirectUrls
+	}
+
+	if known.Email != nil || all {
+		email, knownEmail := &authEmailConfig{}, authEmailConfig{}
+		if known.Email != nil {
+			knownEmail = *known.Email
+		}
+		email.Enabled = r.bool("external_email_enabled", knownEmail.Enabled)
+		email.Autoconfirm = r.bool("mailer_autoconfirm", knownEmail.Autoconfirm)
+		if knownEmail.Smtp != nil || (all && r.string("smtp_host", nil) != nil) {
+			knownSmtp := authSmtpConfig{}
+			if knownEmail.Smtp != nil {
+				knownSmtp = *knownEmail.Smtp
+			}
+			email.Smtp = &authSmtpConfig{
+				Host:       r.string("smtp_host", knownSmtp.Host),
+				Port:       r.int("smtp_port", knownSmtp.Port),
+				User:       r.string("smtp_user", knownSmtp.User),
+				Pass:       r.secret("smtp_pass", knownSmtp.Pass),
+				AdminEmail: r.string("smtp_admin_email", knownSmtp.AdminEmail),
+				SenderName: r.string("smtp_sender_name", knownSmtp.SenderName),
+			}
+		}
+		config.Email = email
+	}
+
+	if known.Sms != nil || all {
+		sms, knownSms := &authSmsConfig{}, authSmsConfig{}
+		if known.Sms != nil {
+			knownSms = *known.Sms
+		}
+		sms.Enabled = r.bool("external_phone_enabled", knownSms.Enabled)
+		sms.Autoconfirm = r.bool("sms_autoconfirm", knownSms.Autoconfirm)
+		sms.Provider = r.string("sms_provider", knownSms.Provider)
+		if knownSms.Twilio != nil || (all && r.string("sms_twilio_account_sid", nil) != nil) {
+			knownTwilio := authTwilioConfig{}
+			if knownSms.Twilio != nil {
+				knownTwilio = *knownSms.Twilio
+			}
+			sms.Twilio = &authTwilioConfig{
+				AccountSid:        r.string("sms_twilio_account_sid", knownTwilio.AccountSid),
+				AuthToken:         r.secret("sms_twilio_auth_token", knownTwilio.AuthToken),
+				MessageServiceSid: r.string("sms_twilio_message_service_sid", knownTwilio.MessageServiceSid),
+			}
+		}
+		config.Sms = sms
+	}
+
+	providers := map[string]authExternalProvider{}
+	for _, name := range authExternalProviders {
+		knownProvider, managed := known.ExternalProviders[name]
+		if enabled := r.bool("external_"+name+"_enabled", nil); !managed && !(all && enabled != nil && *enabled) {
+			continue
+		}
+		providers[name] = authExternalProvider{
+			Enabled:  r.bool("external_"+name+"_enabled", knownProvider.Enabled),
+			ClientId: r.string("external_"+name+"_client_id", knownProvider.ClientId),
+			Secret:   r.secret("external_"+name+"_secret", knownProvider.Secret),
+			Url:      r.string("external_"+name+"_url", knownProvider.Url),
+		}
+	}
+	if len(providers) > 0 {
+		config.ExternalProviders = providers
+	}
+	return config
+}
+
+// authConfigReader reads a setting of the API when it is known by the resource, or always when all is set
+type authConfigReader struct {
+	api client.AuthConfigResponse
+	all bool
+}
+
+func (r authConfigReader) string(key string, known *string) *string {
+	if value, ok := r.api[key].(string); ok && (known != nil || r.all) {
+		return &value
+	}
+	return nil
+}
+
+func (r authConfigReader) bool(key string, known *bool) *bool {
+	if value, ok := r.api[key].(bool); ok && (known != nil || r.all) {
+		return &value
+	}
+	return nil
+}
+
+func (r authConfigReader) int(key string, known *int) *int {
+	if known == nil && !r.all {
+		return nil
+	}
+	switch value := r.api[key].(type) {
+	case float64:
+		number := int(value)
+		return &number
+	case string:
+		if number, err := strconv.Atoi(value); err == nil {
+			return &number
+		}
+	}
+	return nil
+}
+
+// secret keeps the known value while the API returns it, its digest or nothing at all
+func (r authConfigReader) secret(key string, known *string) *string {
+	value := r.string(key, known)
+	if known != nil && (value == nil || *value == "" || *value == *known || *value == secretDigest(*known)) {
+		return known
+	}
+	return value
+}
+
+func isAbsoluteUrl(value string) bool {
+	parsed, err := url.Parse(value)
+	return err == nil && parsed.Scheme != "" && parsed.Host != ""
+}
@@ -0,0 +1,382 @@
+package provider
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/LuxChanLu/pulumi-supabase/pkg/provider/client"
+)
+
+// entrypointNames are looked up, in order, when the source of a function is a directory
+var entrypointNames = []string{"index.ts", "index.js", "index.tsx", "index.jsx", "index.mts", "index.mjs"}
+
+// importMapNames are looked up, in order, next to the entrypoint when no import map is given
+var importMapNames = []string{"import_map.json", "deno.json"}
+
+// importSpecifiers matches the static imports and re-exports, the side effect imports and the dynamic imports of a module
+var importSpecifiers = regexp.MustCompile(`(?m)(?:\b(?:import|export)\b[^'"]*?\bfrom\s*|\bimport\s*\(?\s*)['"]([^'"\n]+)['"]`)
+
+// functionBundle is the set of local files making an edge function, keyed by their path relative to root
+type functionBundle struct {
+	root       string
+	entrypoint string
+	importMap  string
+	files      map[string][]byte
+}
+
+type importMapFile struct {
+	Imports map[string]string `json:"imports"`
+}
+
+// functionBundleOptions are the inputs of a function changing how it is bundled
+type functionBundleOptions struct {
+	// EntrypointPath is the entrypoint relative to the source directory, or the name the body is deployed as
+	EntrypointPath string `json:"entrypointPath"`
+	// ImportMap is an inline import map or the path to one, it replaces the import map found next to the entrypoint
+	ImportMap string `json:"importMap"`
+	// ImportMapPath is where the import map is deployed relative to the entrypoint directory, an import map file keeps
+	// its place otherwise
+	ImportMapPath string `json:"importMapPath"`
+}
+
+// bundleFunction collects the entrypoint found at source with every local module it imports
+func bundleFunction(source string, options functionBundleOptions) (*functionBundle, error) {
+	entrypoint, err := resolveEntrypoint(source, options.EntrypointPath)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string][]byte{}
+	importMapPath, importMapSource, importMap, err := loadImportMap(filepath.Dir(entrypoint), options)
+	if err != nil {
+		return nil, err
+	}
+	imports := map[string]string{}
+	if importMapPath != "" {
+		if options.ImportMapPath == "" {
+			// The import map file keeps its place relative to the modules it maps
+			importMapPath = importMapSource
+		}
+		if imports, err = localImports(importMapSource, importMap); err != nil {
+			return nil, err
+		}
+		if importMap, err = relocateImportMap(importMap, importMapSource, importMapPath); err != nil {
+			return nil, err
+		}
+		files[importMapPath] = importMap
+	}
+	if err := collectModule(entrypoint, imports, files); err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	root := commonDir(paths)
+
+	bundle := &functionBundle{root: root, files: map[string][]byte{}}
+	for path, content := range files {
+		bundle.files[filepath.ToSlash(mustRel(root, path))] = content
+	}
+	bundle.entrypoint = filepath.ToSlash(mustRel(root, entrypoint))
+	if importMapPath != "" {
+		bundle.importMap = filepath.ToSlash(mustRel(root, importMapPath))
+	}
+	return bundle, nil
+}
+
+// bundleBody wraps the inline body of a function in a bundle so it can be deployed with an import map
+func bundleBody(body string, options functionBundleOptions) (*functionBundle, error) {
+	entrypoint := options.EntrypointPath
+	if entrypoint == "" {
+		entrypoint = entrypointNames[0]
+	}
+	entrypoint = filepath.ToSlash(filepath.Clean(filepath.FromSlash(entrypoint)))
+	bundle := &functionBundle{entrypoint: entrypoint, files: map[string][]byte{entrypoint: []byte(body)}}
+	if options.ImportMap == "" {
+		return bundle, nil
+	}
+	importMapPath, _, importMap, err := loadImportMap(".", options)
+	if err != nil {
+		return nil, err
+	}
+	bundle.importMap = filepath.ToSlash(filepath.Clean(importMapPath))
+	bundle.files[bundle.importMap] = importMap
+	return bundle, nil
+}
+
+func resolveEntrypoint(source string, entrypointPath string) (string, error) {
+	source, err := filepath.Abs(source)
+	if err != nil {
+		return "", err
+	}
+	info, err := os.Stat(source)
+	if err != nil {
+		return "", fmt.Errorf("function source: %w", err)
+	}
+	if !info.IsDir() {
+		return source, nil
+	}
+	names := entrypointNames
+	if entrypointPath != "" {
+		names = []string{filepath.FromSlash(entrypointPath)}
+	}
+	for _, name := range names {
+		if _, err := os.Stat(filepath.Join(source, name)); err == nil {
+			return filepath.Join(source, name), nil
+		}
+	}
+	return "", fmt.Errorf("function source: no entrypoint (%s) found in %s", strings.Join(names, ", "), source)
+}
+
+// loadImportMap returns where the import map of the function is deployed, the file its local mappings are relative to
+// and its content
+func loadImportMap(dir string, options functionBundleOptions) (string, string, []byte, error) {
+	if options.ImportMap != "" {
+		content, err := readImportMap(options.ImportMap)
+		if err != nil {
+			return "", "", nil, err
+		}
+		importMapPath := options.ImportMapPath
+		if importMapPath == "" && !isInlineImportMap(options.ImportMap) {
+			importMapPath = filepath.Base(options.ImportMap)
+		}
+		if importMapPath == "" {
+			importMapPath = importMapNames[0]
+		}
+		importMapPath = filepath.Join(dir, filepath.FromSlash(importMapPath))
+		if isInlineImportMap(options.ImportMap) {
+			return importMapPath, importMapPath, content, nil
+		}
+		source, err := filepath.Abs(options.ImportMap)
+		if err != nil {
+			return "", "", nil, err
+		}
+		return importMapPath, source, content, nil
+	}
+	for _, name := range importMapNames {
+		source := filepath.Join(dir, name)
+		content, err := os.ReadFile(source)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return "", "", nil, err
+		}
+		if options.ImportMapPath != "" {
+			return filepath.Join(dir, filepath.FromSlash(options.ImportMapPath)), source, content, nil
+		}
+		return source, source, content, nil
+	}
+	return "", "", nil, nil
+}
+
+// readImportMap reads an import map given either inline or as the path to its file, and checks it is valid
+func readImportMap(importMap string) ([]byte, error) {
+	content := []byte(importMap)
+	if !isInlineImportMap(importMap) {
+		var err error
+		if content, err = os.ReadFile(importMap); err != nil {
+			return nil, fmt.Errorf("import map: %w", err)
+		}
+	}
+	if err := json.Unmarshal(content, &importMapFile{}); err != nil {
+		return nil, fmt.Errorf("import map: %w", err)
+	}
+	return content, nil
+}
+
+func isInlineImportMap(importMap string) bool {
+	return strings.HasPrefix(strings.TrimSpace(importMap), "{")
+}
+
+// localImports returns the mappings of an import map to local paths, resolved from the file it is read from
+func localImports(importMapPath string, content []byte) (map[string]string, error) {
+	importMap := importMapFile{}
+	if err := json.Unmarshal(content, &importMap); err != nil {
+		return nil, fmt.Errorf("import map %s: %w", importMapPath, err)
+	}
+	dir := filepath.Dir(importMapPath)
+	imports := map[string]string{}
+	for specifier, target := range importMap.Imports {
+		if isLocalSpecifier(target) {
+			imports[specifier] = filepath.Join(dir, filepath.FromSlash(target))
+			if strings.HasSuffix(target, "/") {
+				imports[specifier] += string(filepath.Separator)
+			}
+		}
+	}
+	return imports, nil
+}
+
+// relocateImportMap rewrites the relative mappings of an import map read from source so they still point to the same
+// modules once it is deployed to path
+func relocateImportMap(content []byte, source, path string) ([]byte, error) {
+	from, to := filepath.Dir(source), filepath.Dir(path)
+	if from == to {
+		return content, nil
+	}
+	importMap := map[string]json.RawMessage{}
+	if err := json.Unmarshal(content, &importMap); err != nil {
+		return nil, fmt.Errorf("import map %s: %w", source, err)
+	}
+	imports := map[string]string{}
+	if raw, ok := importMap["imports"]; ok {
+		if err := json.Unmarshal(raw, &imports); err != nil {
+			return nil, fmt.Errorf("import map %s: %w", source, err)
+		}
+	}
+	for specifier, target := range imports {
+		if !strings.HasPrefix(target, "./") && !strings.HasPrefix(target, "../") {
+			continue
+		}
+		relocated := filepath.ToSlash(mustRel(to, filepath.Join(from, filepath.FromSlash(target))))
+		if relocated != "." && relocated != ".." && !strings.HasPrefix(relocated, "../") {
+			relocated = "./" + relocated
+		}
+		if strings.HasSuffix(target, "/") {
+			relocated += "/"
+		}
+		imports[specifier] = relocated
+	}
+	raw, err := json.Marshal(imports)
+	if err != nil {
+		return nil, err
+	}
+	importMap["imports"] = raw
+	return json.MarshalIndent(importMap, "", "  ")
+}
+
+func collectModule(path string, imports map[string]string, files map[string][]byte) error {
+	if _, ok := files[path]; ok {
+		return nil
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("function source: %w", err)
+	}
+	files[path] = content
+
+	for _, match := range importSpecifiers.FindAllSubmatch(content, -1) {
+		dependency, ok := resolveSpecifier(string(match[1]), filepath.Dir(path), imports)
+		if !ok {
+			continue
+		}
+		if err := collectModule(dependency, imports, files); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveSpecifier returns the local file imported by a specifier, remote and bare specifiers are left to the runtime
+func resolveSpecifier(specifier, dir string, imports map[string]string) (string, bool) {
+	if target, ok := imports[specifier]; ok {
+		return target, true
+	}
+	longest := ""
+	for prefix := range imports {
+		if strings.HasSuffix(prefix, "/") && strings.HasPrefix(specifier, prefix) && len(prefix) > len(longest) {
+			longest = prefix
+		}
+	}
+	if longest != "" {
+		return imports[longest] + filepath.FromSlash(strings.TrimPrefix(specifier, longest)), true
+	}
+	if strings.HasPrefix(specifier, "file://") {
+		return filepath.FromSlash(strings.TrimPrefix(specifier, "file://")), true
+	}
+	if isLocalSpecifier(specifier) {
+		return filepath.Join(dir, filepath.FromSlash(specifier)), true
+	}
+	return "", false
+}
+
+func isLocalSpecifier(specifier string) bool {
+	return strings.HasPrefix(specifier, "./") || strings.HasPrefix(specifier, "../") || strings.HasPrefix(specifier, "/")
+}
+
+// hash is the content hash of the bundle, it changes whenever a file is added, removed, renamed or edited
+func (b *functionBundle) hash() string {
+	hash := sha256.New()
+	for _, path := range b.paths() {
+		hash.Write([]byte(path))
+		hash.Write([]byte{0})
+		hash.Write(b.files[path])
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func (b *functionBundle) paths() []string {
+	paths := make([]string, 0, len(b.files))
+	for path := range b.files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// multipart encodes the bundle as expected by the deploy endpoint: a metadata part followed by one part per file
+func (b *functionBundle) multipart(metadata client.DeployFunctionMetadata) (string, *bytes.Reader, error) {
+	metadata.EntrypointPath = b.entrypoint
+	if b.importMap != "" {
+		metadata.ImportMapPath = &b.importMap
+	}
+	encodedMetadata, err := json.Marshal(metadata)
+	if err != nil {
+		return "", nil, err
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	if err := writer.WriteField("metadata", string(encodedMetadata)); err != nil {
+		return "", nil, err
+	}
+	for _, path := range b.paths() {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, path))
+		header.Set("Content-Type", "application/octet-stream")
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			return "", nil, err
+		}
+		if _, err := part.Write(b.files[path]); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return "", nil, err
+	}
+	return writer.FormDataContentType(), bytes.NewReader(body.Bytes()), nil
+}
+
+// commonDir is the deepest directory containing every path
+func commonDir(paths []string) string {
+	root := filepath.Dir(paths[0])
+	for _, path := range paths[1:] {
+		for root != filepath.Dir(root) && !strings.HasPrefix(path, root+string(filepath.Separator)) {
+			root = filepath.Dir(root)
+		}
+	}
+	return root
+}
+
+func mustRel(root, path string) string {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return path
+	}
+	return rel
+}
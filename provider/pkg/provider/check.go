@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+// resourceChecks validate the inputs of a resource type beyond what the schema declares, unknown values are skipped
+var resourceChecks = map[tokens.Type]func(inputs resource.PropertyMap) []*pulumirpc.CheckFailure{
+	"supabase:index:Organization": checkOrganization,
+	"supabase:index:Project":      checkProject,
+	"supabase:index:Function":     checkFunction,
+	"supabase:index:Secret":       checkSecret,
+	"supabase:index:SecretSet":    checkSecretSet,
+
+	"supabase:index:ProjectAuthConfig":   checkAuthConfig,
+	"supabase:index:PostgrestConfig":     checkPostgrestConfig,
+	"supabase:index:NetworkRestrictions": checkNetworkRestrictions,
+	"supabase:index:NetworkBanRemoval":   checkNetworkBanRemoval,
+	"supabase:index:SslEnforcement":      checkSslEnforcement,
+	"supabase:index:CustomHostname":      checkCustomHostname,
+	"supabase:index:VanitySubdomain":     checkVanitySubdomain,
+}
+
+// functionSlugPattern is the slug format accepted by the API
+var functionSlugPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+
+// secretNamePattern is the environment variable name format accepted by the API
+var secretNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func checkFailure(property interface{}, format string, args ...interface{}) *pulumirpc.CheckFailure {
+	return &pulumirpc.CheckFailure{Property: fmt.Sprint(property), Reason: fmt.Sprintf(format, args...)}
+}
+
+// stringInput returns a known string input, unwrapping secrets
+func stringInput(inputs resource.PropertyMap, key resource.PropertyKey) (string, bool) {
+	value, ok := inputs[key]
+	if ok && value.IsSecret() {
+		value = value.SecretValue().Element
+	}
+	if !ok || !value.IsString() {
+		return "", false
+	}
+	return value.StringValue(), true
+}
+
+// checkNotEmpty reports the known string inputs set to an empty or blank value
+func checkNotEmpty(inputs resource.PropertyMap, keys ...resource.PropertyKey) []*pulumirpc.CheckFailure {
+	failures := []*pulumirpc.CheckFailure{}
+	for _, key := range keys {
+		if value, ok := stringInput(inputs, key); ok && strings.TrimSpace(value) == "" {
+			failures = append(failures, checkFailure(key, "%s must not be empty", key))
+		}
+	}
+	return failures
+}
+
+// checkSecretName validates the name of an edge function secret
+func checkSecretName(property interface{}, name string) []*pulumirpc.CheckFailure {
+	switch {
+	case strings.HasPrefix(strings.ToUpper(name), reservedSecretPrefix):
+		return []*pulumirpc.CheckFailure{checkFailure(property, "secret names starting with %s are reserved by Supabase", reservedSecretPrefix)}
+	case !secretNamePattern.MatchString(name):
+		return []*pulumirpc.CheckFailure{checkFailure(property, "'%s' is not a valid secret name, expected letters, digits and underscores not starting with a digit", name)}
+	}
+	return nil
+}
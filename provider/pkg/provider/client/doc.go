@@ -0,0 +1,5 @@
+package client
+
+// supabase.gen.go is generated by oapi-codegen from the published OpenAPI spec of the Management API. The endpoints
+// that are not (yet) part of it are written by hand in auth.go, function.go and project.go, following the generated
+// code layout so they can be dropped once the spec exposes them.
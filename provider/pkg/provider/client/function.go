@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+// Endpoint deploying a function from a multipart bundle of its files.
+
+// DeployFunctionMetadata defines model for DeployFunctionMetadata, sent as the `metadata` part of a deploy.
+type DeployFunctionMetadata struct {
+	EntrypointPath string  `json:"entrypoint_path"`
+	ImportMapPath  *string `json:"import_map_path,omitempty"`
+	Name           *string `json:"name,omitempty"`
+	VerifyJwt      *bool   `json:"verify_jwt,omitempty"`
+}
+
+// DeployFunctionParams defines parameters for DeployFunction.
+type DeployFunctionParams struct {
+	Slug string `form:"slug" json:"slug"`
+}
+
+// NewDeployFunctionRequestWithBody generates requests for DeployFunction with a multipart body
+func NewDeployFunctionRequestWithBody(server string, ref string, params *DeployFunctionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ref", runtime.ParamLocationPath, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/functions/deploy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "slug", runtime.ParamLocationQuery, params.Slug); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) DeployFunctionWithBody(ctx context.Context, ref string, params *DeployFunctionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeployFunctionRequestWithBody(c.Server, ref, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+type DeployFunctionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *FunctionResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeployFunctionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeployFunctionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// DeployFunctionWithBodyWithResponse request with a multipart body returning *DeployFunctionResponse
+func (c *ClientWithResponses) DeployFunctionWithBodyWithResponse(ctx context.Context, ref string, params *DeployFunctionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeployFunctionResponse, error) {
+	client, err := c.client()
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := client.DeployFunctionWithBody(ctx, ref, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeployFunctionResponse(rsp)
+}
+
+// ParseDeployFunctionResponse parses an HTTP response from a DeployFunctionWithBodyWithResponse call
+func ParseDeployFunctionResponse(rsp *http.Response) (*DeployFunctionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeployFunctionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && (rsp.StatusCode == 200 || rsp.StatusCode == 201):
+		var dest FunctionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
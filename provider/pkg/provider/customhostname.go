@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/LuxChanLu/pulumi-supabase/pkg/provider/client"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+const defaultCustomHostnameVerifyTimeout = 30 * time.Minute
+const customHostnamePollMinInterval = 10 * time.Second
+const customHostnamePollMaxInterval = time.Minute
+
+var hostnamePattern = regexp.MustCompile(`^(?:[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,63}$`)
+
+// verifiedCustomHostnameStatuses are the statuses of a hostname whose DNS records are verified, ready to be activated
+var verifiedCustomHostnameStatuses = []string{string(client.N4OriginSetupCompleted), string(client.N5ServicesReconfigured)}
+
+// customHostnameArgs are the inputs of the custom hostname of a project
+type customHostnameArgs struct {
+	ProjectId           string `json:"projectId"`
+	Hostname            string `json:"hostname"`
+	WaitForVerification bool   `json:"waitForVerification,omitempty"`
+}
+
+// customHostnameState are the outputs of the custom hostname of a project
+type customHostnameState struct {
+	ProjectId string `json:"projectId"`
+	Hostname  string `json:"hostname"`
+	Status    string `json:"status"`
+	Activated bool   `json:"activated"`
+	// DnsRecords are the records to create at the DNS provider of the hostname for it to be verified
+	DnsRecords []dnsRecord `json:"dnsRecords"`
+}
+
+type dnsRecord struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+func checkCustomHostname(inputs resource.PropertyMap) []*pulumirpc.CheckFailure {
+	hostname, ok := stringInput(inputs, "hostname")
+	if !ok {
+		return nil
+	}
+	if !hostnamePattern.MatchString(hostname) {
+		return []*pulumirpc.CheckFailure{checkFailure("hostname", "%s is not a valid lowercase hostname", hostname)}
+	}
+	if hostname == "supabase.co" || strings.HasSuffix(hostname, ".supabase.co") {
+		return []*pulumirpc.CheckFailure{checkFailure("hostname", "%s is a supabase.co hostname, use a VanitySubdomain instead", hostname)}
+	}
+	return nil
+}
+
+func newCustomHostnameState(projectId string, config client.UpdateCustomHostnameResponse) *customHostnameState {
+	state := &customHostnameState{
+		ProjectId: projectId,
+		Hostname:  config.CustomHostname,
+		Status:    string(config.Status),
+		Activated: config.Status == client.N5ServicesReconfigured,
+		DnsRecords: []dnsRecord{
+			{Type: "CNAME", Name: config.CustomHostname, Value: fmt.Sprintf("%s.supabase.co", projectId)},
+		},
+	}
+
+	// data is the custom hostname as returned by Cloudflare, its ownership and certificate validations are TXT records
+	result, _ := config.Data["result"].(map[string]interface{})
+	if ownership, ok := result["ownership_verification"].(map[string]interface{}); ok {
+		state.DnsRecords = append(state.DnsRecords, newDnsRecord(ownership, "type", "name", "value"))
+	}
+	if ssl, ok := result["ssl"].(map[string]interface{}); ok {
+		if records, ok := ssl["validation_records"].([]interface{}); ok {
+			for _, record := range records {
+				if record, ok := record.(map[string]interface{}); ok && record["txt_name"] != nil {
+					state.DnsRecords = append(state.DnsRecords, newDnsRecord(record, "", "txt_name", "txt_value"))
+				}
+			}
+		} else if ssl["txt_name"] != nil {
+			state.DnsRecords = append(state.DnsRecords, newDnsRecord(ssl, "", "txt_name", "txt_value"))
+		}
+	}
+	return state
+}
+
+func newDnsRecord(record map[string]interface{}, typeKey, nameKey, valueKey string) dnsRecord {
+	recordType, _ := record[typeKey].(string)
+	if recordType == "" {
+		recordType = "TXT"
+	}
+	name, _ := record[nameKey].(string)
+	value, _ := record[valueKey].(string)
+	return dnsRecord{Type: strings.ToUpper(recordType), Name: name, Value: value}
+}
+
+func (p *supabaseProvider) createCustomHostname(ctx context.Context, urn resource.URN, inputs resource.PropertyMap, timeout time.Duration, preview bool) (string, *customHostnameState, error) {
+	args := customHostnameArgs{}
+	if err := propertiesMapToStruct(inputs, &args); err != nil {
+		return "", nil, err
+	}
+	if preview {
+		return "", &customHostnameState{ProjectId: args.ProjectId, Hostname: args.Hostname}, nil
+	}
+	res, err := p.supabase.CreateCustomHostnameConfigWithResponse(ctx, args.ProjectId, client.CreateCustomHostnameConfigJSONRequestBody{CustomHostname: args.Hostname})
+	if err := checkForSupabaseError(res, err); err != nil {
+		return "", nil, err
+	}
+	if res.JSON201 == nil {
+		return "", nil, errUnexpectedResponse(res)
+	}
+	state := newCustomHostnameState(args.ProjectId, *res.JSON201)
+	if args.WaitForVerification {
+		if state, err = p.activateCustomHostname(ctx, urn, state, timeout); err != nil {
+			return args.ProjectId, state, err
+		}
+	}
+	return args.ProjectId, state, nil
+}
+
+func (p *supabaseProvider) readCustomHostname(ctx context.Context, projectId string) (string, *customHostnameState, error) {
+	res, err := p.supabase.GetCustomHostnameConfigWithResponse(ctx, projectId)
+	if err := checkForSupabaseError(res, err); err != nil {
+		if isNotFound(err) {
+			return "", nil, nil
+		}
+		return "", nil, err
+	}
+	if res.JSON200 == nil {
+		return "", nil, errUnexpectedResponse(res)
+	}
+	if res.JSON200.CustomHostname == "" || res.JSON200.Status == client.N1NotStarted {
+		return "", nil, nil
+	}
+	return projectId, newCustomHostnameState(projectId, *res.JSON200), nil
+}
+
+// updateCustomHostname waits for the verification of the hostname once it is asked to, the hostname itself is replaced
+func (p *supabaseProvider) updateCustomHostname(ctx context.Context, urn resource.URN, projectId string, news resource.PropertyMap, timeout time.Duration, preview bool) (*customHostnameState, error) {
+	args := customHostnameArgs{}
+	if err := propertiesMapToStruct(news, &args); err != nil {
+		return nil, err
+	}
+	_, state, err := p.readCustomHostname(ctx, projectId)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, fmt.Errorf("custom hostname of project %s not found", projectId)
+	}
+	if preview || !args.WaitForVerification || state.Activated {
+		return state, nil
+	}
+	return p.activateCustomHostname(ctx, urn, state, timeout)
+}
+
+// activateCustomHostname asks for the verification of the hostname until its DNS records are found, then activates it
+func (p *supabaseProvider) activateCustomHostname(ctx context.Context, urn resource.URN, state *customHostnameState, timeout time.Duration) (*customHostnameState, error) {
+	if timeout <= 0 {
+		timeout = defaultCustomHostnameVerifyTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for _, record := range state.DnsRecords {
+		p.logStatus(ctx, urn, fmt.Sprintf("waiting for the %s record %s = %s", record.Type, record.Name, record.Value))
+	}
+	interval := customHostnamePollMinInterval
+	for !containsString(verifiedCustomHostnameStatuses, state.Status) {
+		select {
+		case <-ctx.Done():
+			return state, fmt.Errorf("custom hostname %s was not verified within %s (last status: %s)", state.Hostname, timeout, state.Status)
+		case <-time.After(interval):
+		}
+		if interval *= 2; interval > customHostnamePollMaxInterval {
+			interval = customHostnamePollMaxInterval
+		}
+		res, err := p.supabase.ReverifyWithResponse(ctx, state.ProjectId)
+		if ctx.Err() != nil {
+			return state, fmt.Errorf("custom hostname %s was not verified within %s (last status: %s)", state.Hostname, timeout, state.Status)
+		}
+		if err := checkForSupabaseError(res, err); err != nil {
+			return state, err
+		}
+		if res.JSON201 != nil {
+			state = newCustomHostnameState(state.ProjectId, *res.JSON201)
+		}
+	}
+	if state.Activated {
+		return state, nil
+	}
+
+	res, err := p.supabase.ActivateWithResponse(withRetrySafe(ctx), state.ProjectId)
+	if err := checkForSupabaseError(res, err); err != nil {
+		return state, err
+	}
+	if res.JSON201 == nil {
+		return state, errUnexpectedResponse(res)
+	}
+	return newCustomHostnameState(state.ProjectId, *res.JSON201), nil
+}
+
+func (p *supabaseProvider) deleteCustomHostname(ctx context.Context, projectId string) error {
+	res, err := p.supabase.RemoveCustomHostnameConfigWithResponse(ctx, projectId)
+	if err := checkForSupabaseError(res, err); err != nil && !isNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"sort"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+// resourceDiff declares how changes to the inputs of a resource type are applied
+type resourceDiff struct {
+	// updates are applied in place by Update
+	updates []resource.PropertyKey
+	// replaces force a new resource
+	replaces []resource.PropertyKey
+	// deleteBeforeReplace are the replacing keys for which the old resource must be deleted first
+	deleteBeforeReplace []resource.PropertyKey
+	// backfills are the keys older or imported states may lack, they are taken from the new inputs so they are not seen as added
+	backfills []resource.PropertyKey
+}
+
+// resourceDiffs is the table Diff is computed from, every resource type must be listed
+var resourceDiffs = map[tokens.Type]resourceDiff{
+	"supabase:index:Organization": {
+		replaces: []resource.PropertyKey{"name"},
+	},
+	"supabase:index:Project": {
+		updates:             []resource.PropertyKey{"name", "plan", "kps_enabled", "deletionProtection"},
+		replaces:            []resource.PropertyKey{"organization_id", "db_pass", "region"},
+		deleteBeforeReplace: []resource.PropertyKey{"region"},
+		backfills:           []resource.PropertyKey{"db_pass"},
+	},
+	"supabase:index:Function": {
+		updates:             []resource.PropertyKey{"name", "bodyHash", "source", "sourceHash", "importMap", "entrypointPath", "importMapPath", "verify_jwt"},
+		replaces:            []resource.PropertyKey{"projectId", "slug"},
+		deleteBeforeReplace: []resource.PropertyKey{"slug"},
+		backfills:           []resource.PropertyKey{"projectId"},
+	},
+	"supabase:index:Secret": {
+		updates:   []resource.PropertyKey{"value"},
+		replaces:  []resource.PropertyKey{"projectId", "name"},
+		backfills: []resource.PropertyKey{"projectId"},
+	},
+	"supabase:index:SecretSet": {
+		updates:  []resource.PropertyKey{"secrets", "prune"},
+		replaces: []resource.PropertyKey{"projectId"},
+	},
+	"supabase:index:ProjectAuthConfig": {
+		updates:  []resource.PropertyKey{"siteUrl", "additionalRedirectUrls", "jwtExpiry", "disableSignup", "email", "sms", "externalProviders"},
+		replaces: []resource.PropertyKey{"projectId"},
+	},
+	"supabase:index:PostgrestConfig": {
+		updates:  []resource.PropertyKey{"dbSchema", "dbExtraSearchPath", "maxRows"},
+		replaces: []resource.PropertyKey{"projectId"},
+	},
+	"supabase:index:NetworkRestrictions": {
+		updates:  []resource.PropertyKey{"dbAllowedCidrs"},
+		replaces: []resource.PropertyKey{"projectId"},
+	},
+	"supabase:index:NetworkBanRemoval": {
+		updates:  []resource.PropertyKey{"ipv4Addresses", "triggers"},
+		replaces: []resource.PropertyKey{"projectId"},
+	},
+	"supabase:index:SslEnforcement": {
+		updates:  []resource.PropertyKey{"database"},
+		replaces: []resource.PropertyKey{"projectId"},
+	},
+	"supabase:index:CustomHostname": {
+		updates:             []resource.PropertyKey{"waitForVerification"},
+		replaces:            []resource.PropertyKey{"projectId", "hostname"},
+		deleteBeforeReplace: []resource.PropertyKey{"hostname"},
+	},
+	"supabase:index:VanitySubdomain": {
+		replaces:            []resource.PropertyKey{"projectId", "subdomain"},
+		deleteBeforeReplace: []resource.PropertyKey{"projectId", "subdomain"},
+	},
+}
+
+func (d resourceDiff) keys() []resource.PropertyKey {
+	return append(append([]resource.PropertyKey{}, d.updates...), d.replaces...)
+}
+
+func (d resourceDiff) diff(olds, news resource.PropertyMap, ignoreChanges []string) *pulumirpc.DiffResponse {
+	ignored := map[string]bool{}
+	for _, key := range ignoreChanges {
+		ignored[key] = true
+	}
+
+	response := &pulumirpc.DiffResponse{
+		Changes:         pulumirpc.DiffResponse_DIFF_NONE,
+		Replaces:        []string{},
+		Diffs:           []string{},
+		DetailedDiff:    map[string]*pulumirpc.PropertyDiff{},
+		HasDetailedDiff: true,
+	}
+	if len(d.backfills) > 0 {
+		olds = olds.Copy()
+		for _, key := range d.backfills {
+			if value, ok := news[key]; ok && !olds.HasValue(key) {
+				olds[key] = value
+			}
+		}
+	}
+	diff := olds.Diff(news)
+	if diff == nil {
+		return response
+	}
+
+	for _, key := range d.keys() {
+		if ignored[string(key)] || !diff.Changed(key) {
+			continue
+		}
+		replace := containsKey(d.replaces, key)
+		response.Diffs = append(response.Diffs, string(key))
+		response.DetailedDiff[string(key)] = &pulumirpc.PropertyDiff{Kind: propertyDiffKind(diff, key, replace), InputDiff: true}
+		if replace {
+			response.Replaces = append(response.Replaces, string(key))
+			response.DeleteBeforeReplace = response.DeleteBeforeReplace || containsKey(d.deleteBeforeReplace, key)
+		}
+	}
+	if len(response.DetailedDiff) > 0 {
+		response.Changes = pulumirpc.DiffResponse_DIFF_SOME
+	}
+	sort.Strings(response.Replaces)
+	return response
+}
+
+// keepInputs copies the diffed inputs the API does not return into the outputs so the next Diff can compare them
+func (d resourceDiff) keepInputs(inputs, outputs resource.PropertyMap) {
+	for _, key := range d.keys() {
+		if _, ok := outputs[key]; ok {
+			continue
+		}
+		if value, ok := inputs[key]; ok {
+			outputs[key] = value
+		}
+	}
+}
+
+// inputsOf rebuilds the inputs of a resource from its outputs so Read can report them on import and refresh
+func (d resourceDiff) inputsOf(outputs resource.PropertyMap) resource.PropertyMap {
+	inputs := resource.PropertyMap{}
+	for _, key := range d.keys() {
+		if value, ok := outputs[key]; ok {
+			inputs[key] = value
+		}
+	}
+	return inputs
+}
+
+func propertyDiffKind(diff *resource.ObjectDiff, key resource.PropertyKey, replace bool) pulumirpc.PropertyDiff_Kind {
+	switch {
+	case diff.Added(key) && replace:
+		return pulumirpc.PropertyDiff_ADD_REPLACE
+	case diff.Added(key):
+		return pulumirpc.PropertyDiff_ADD
+	case diff.Deleted(key) && replace:
+		return pulumirpc.PropertyDiff_DELETE_REPLACE
+	case diff.Deleted(key):
+		return pulumirpc.PropertyDiff_DELETE
+	case replace:
+		return pulumirpc.PropertyDiff_UPDATE_REPLACE
+	default:
+		return pulumirpc.PropertyDiff_UPDATE
+	}
+}
+
+func containsKey(keys []resource.PropertyKey, key resource.PropertyKey) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// supabaseError is an error answered by the Supabase Management API
+type supabaseError struct {
+	StatusCode int
+	Status     string
+	Message    string
+	RequestId  string
+	RetryAfter string
+}
+
+// supabaseErrorPayload covers the error shapes returned by the Management API and its gateway
+type supabaseErrorPayload struct {
+	Message          string `json:"message"`
+	Msg              string `json:"msg"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func newSupabaseError(res *http.Response, body []byte) *supabaseError {
+	err := &supabaseError{
+		StatusCode: res.StatusCode,
+		Status:     res.Status,
+		RequestId:  res.Header.Get("X-Request-Id"),
+		RetryAfter: res.Header.Get("Retry-After"),
+	}
+	if err.RequestId == "" {
+		err.RequestId = res.Header.Get("Cf-Ray")
+	}
+
+	payload := supabaseErrorPayload{}
+	if json.Unmarshal(body, &payload) == nil {
+		for _, message := range []string{payload.Message, payload.Msg, payload.ErrorDescription, payload.Error} {
+			if message != "" {
+				err.Message = message
+				break
+			}
+		}
+	}
+	if err.Message == "" {
+		err.Message = strings.TrimSpace(string(body))
+	}
+	return err
+}
+
+func (e *supabaseError) Error() string {
+	message := fmt.Sprintf("supabase API error: %s", e.Status)
+	if e.Message != "" {
+		message = fmt.Sprintf("%s: %s", message, e.Message)
+	}
+	if e.RequestId != "" {
+		message = fmt.Sprintf("%s (request id: %s)", message, e.RequestId)
+	}
+	if _, err := strconv.Atoi(e.RetryAfter); err == nil && e.Retryable() {
+		message = fmt.Sprintf("%s, retry after %ss", message, e.RetryAfter)
+	} else if e.Retryable() && e.RetryAfter != "" {
+		message = fmt.Sprintf("%s, retry after %s", message, e.RetryAfter)
+	}
+	return message
+}
+
+// GRPCStatus lets gRPC report the error with a code matching the HTTP status
+func (e *supabaseError) GRPCStatus() *status.Status {
+	return status.New(e.Code(), e.Error())
+}
+
+func (e *supabaseError) Code() codes.Code {
+	switch {
+	case e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity:
+		return codes.InvalidArgument
+	case e.StatusCode == http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case e.StatusCode == http.StatusForbidden:
+		return codes.PermissionDenied
+	case e.StatusCode == http.StatusNotFound:
+		return codes.NotFound
+	case e.StatusCode == http.StatusConflict:
+		return codes.AlreadyExists
+	case e.StatusCode == http.StatusPreconditionFailed:
+		return codes.FailedPrecondition
+	case e.StatusCode == http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case e.StatusCode == http.StatusNotImplemented:
+		return codes.Unimplemented
+	case e.StatusCode == http.StatusBadGateway || e.StatusCode == http.StatusServiceUnavailable || e.StatusCode == http.StatusGatewayTimeout:
+		return codes.Unavailable
+	case e.StatusCode >= http.StatusInternalServerError:
+		return codes.Internal
+	default:
+		return codes.Unknown
+	}
+}
+
+// Retryable reports if the same request may succeed later
+func (e *supabaseError) Retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.Code() == codes.Unavailable
+}
+
+// isNotFound reports if the API answered that the resource does not exist
+func isNotFound(err error) bool {
+	var supabaseErr *supabaseError
+	return errors.As(err, &supabaseErr) && supabaseErr.StatusCode == http.StatusNotFound
+}
+
+// errUnexpectedResponse is returned when a successful call does not carry the expected JSON payload
+func errUnexpectedResponse(res interface{}) error {
+	if httpResponse, _ := rawResponse(res); httpResponse != nil {
+		return fmt.Errorf("unexpected supabase response: %s (%s)", httpResponse.Status, httpResponse.Header.Get("Content-Type"))
+	}
+	return fmt.Errorf("unexpected supabase response")
+}
@@ -2,89 +2,289 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/LuxChanLu/pulumi-supabase/pkg/provider/client"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
 )
 
-func (p *supabaseProvider) createFunction(ctx context.Context, inputs resource.PropertyMap, projectId string, preview bool, outputs *map[string]interface{}) (string, error) {
-	body := &client.CreateFunctionParams{}
-	if err := propertiesMapToStruct(inputs, &body); err != nil {
-		return "", err
+// functionArgs are the inputs of a function
+type functionArgs struct {
+	functionBundleOptions
+	ProjectId string  `json:"projectId"`
+	Name      string  `json:"name"`
+	Slug      string  `json:"slug"`
+	Body      *string `json:"body,omitempty"`
+	Source    *string `json:"source,omitempty"`
+	VerifyJwt *bool   `json:"verify_jwt,omitempty"`
+}
+
+// functionState are the outputs of a function
+type functionState struct {
+	ProjectId    string `json:"projectId"`
+	Name         string `json:"name"`
+	Slug         string `json:"slug"`
+	Status       string `json:"status"`
+	Version      int    `json:"version"`
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updatedAt"`
+	VerifyJwt    *bool  `json:"verify_jwt,omitempty"`
+	HasImportMap *bool  `json:"hasImportMap,omitempty"`
+	BodyHash     string `json:"bodyHash,omitempty"`
+	SourceHash   string `json:"sourceHash,omitempty"`
+}
+
+// functionResponse is decoded from the raw API response, the generated client parses the timestamps as float32
+// which truncates them
+type functionResponse struct {
+	Name      string  `json:"name"`
+	Slug      string  `json:"slug"`
+	Status    string  `json:"status"`
+	Version   float64 `json:"version"`
+	CreatedAt float64 `json:"created_at"`
+	UpdatedAt float64 `json:"updated_at"`
+	VerifyJwt *bool   `json:"verify_jwt,omitempty"`
+	ImportMap *bool   `json:"import_map,omitempty"`
+}
+
+func newFunctionState(projectId string, body []byte) (*functionState, error) {
+	function := functionResponse{}
+	if err := json.Unmarshal(body, &function); err != nil {
+		return nil, err
 	}
-	if !preview {
-		function, err := p.supabase.CreateFunctionWithBodyWithResponse(ctx, projectId, body, "application/json", strings.NewReader(((*outputs)["body"]).(string)))
-		if err := checkForSupabaseError(function.HTTPResponse, err); err != nil {
-			return "", err
-		}
-		if err := structToOutputs(function.JSON201, outputs); err != nil {
-			return "", err
+	return &functionState{
+		ProjectId:    projectId,
+		Name:         function.Name,
+		Slug:         function.Slug,
+		Status:       function.Status,
+		Version:      int(function.Version),
+		CreatedAt:    formatTimestamp(function.CreatedAt),
+		UpdatedAt:    formatTimestamp(function.UpdatedAt),
+		VerifyJwt:    function.VerifyJwt,
+		HasImportMap: function.ImportMap,
+	}, nil
+}
+
+func (p *supabaseProvider) createFunction(ctx context.Context, inputs resource.PropertyMap, preview bool) (string, *functionState, error) {
+	args := functionArgs{}
+	if err := propertiesMapToStruct(inputs, &args); err != nil {
+		return "", nil, err
+	}
+	if preview {
+		return "", &functionState{ProjectId: args.ProjectId, Name: args.Name, Slug: args.Slug, Status: string(client.FunctionResponseStatusACTIVE), VerifyJwt: args.VerifyJwt}, nil
+	}
+	if bundle, err := functionBundleOf(inputs); err != nil {
+		return "", nil, err
+	} else if bundle != nil {
+		state, err := p.deployFunction(ctx, args.ProjectId, args.Slug, bundle, &args.Name, args.VerifyJwt)
+		if err != nil {
+			return "", nil, err
 		}
-		return function.JSON201.Id, nil
+		return projectResourceId(args.ProjectId, args.Slug), state, nil
+	}
+	if args.Body == nil {
+		return "", nil, fmt.Errorf("function %s has neither a body nor a source", args.Slug)
 	}
-	if err := structToOutputs(client.FunctionResponse{Name: *body.Name, Slug: *body.Slug, Status: client.FunctionResponseStatusACTIVE, VerifyJwt: body.VerifyJwt}, outputs); err != nil {
-		return "", err
+	function, err := p.supabase.CreateFunctionWithResponse(ctx, args.ProjectId, &client.CreateFunctionParams{}, client.CreateFunctionJSONRequestBody{
+		Body:      *args.Body,
+		Name:      args.Name,
+		Slug:      args.Slug,
+		VerifyJwt: args.VerifyJwt,
+	})
+	if err := checkForSupabaseError(function, err); err != nil {
+		return "", nil, err
 	}
-	return "", nil
+	if function.JSON201 == nil {
+		return "", nil, errUnexpectedResponse(function)
+	}
+	state, err := newFunctionState(args.ProjectId, function.Body)
+	if err != nil {
+		return "", nil, err
+	}
+	state.BodyHash = functionBodyHash(inputs)
+	return projectResourceId(args.ProjectId, state.Slug), state, nil
 }
 
-func (p *supabaseProvider) readFunction(ctx context.Context, projectId, slug string, outputs *map[string]interface{}) (string, error) {
+func (p *supabaseProvider) readFunction(ctx context.Context, projectId, slug string, known resource.PropertyMap) (string, *functionState, error) {
 	function, err := p.supabase.GetFunctionWithResponse(ctx, projectId, slug)
+	if err := checkForSupabaseError(function, err); err != nil {
+		if isNotFound(err) {
+			return "", nil, nil
+		}
+		return "", nil, err
+	}
+	if function.JSON200 == nil {
+		return "", nil, errUnexpectedResponse(function)
+	}
+	state, err := newFunctionState(projectId, function.Body)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
-	if function.JSON200 != nil {
-		functionBody, err := p.supabase.GetFunctionBodyWithResponse(ctx, projectId, slug)
-		if err != nil {
-			return "", err
-		}
-		if err := structToOutputs(function.JSON200, outputs); err != nil {
-			return "", err
-		}
-		(*outputs)["body"] = functionBody.Body
-		return function.JSON200.Id, nil
+	// The deployed body is a bundle which cannot be compared to the inputs, the known hashes are kept from the state
+	if known["bodyHash"].IsString() {
+		state.BodyHash = known["bodyHash"].StringValue()
+	}
+	if known["sourceHash"].IsString() {
+		state.SourceHash = known["sourceHash"].StringValue()
 	}
-	return "", nil
+	return projectResourceId(projectId, state.Slug), state, nil
 }
 
-func (p *supabaseProvider) updateFunction(ctx context.Context, inputs resource.PropertyMap, projectId, slug string, preview bool, outputs *map[string]interface{}) error {
-	params := client.UpdateFunctionParams{}
-	if err := propertiesMapToStruct(inputs, &params); err != nil {
-		return err
+func (p *supabaseProvider) updateFunction(ctx context.Context, inputs resource.PropertyMap, preview bool) (*functionState, error) {
+	args := functionArgs{}
+	if err := propertiesMapToStruct(inputs, &args); err != nil {
+		return nil, err
 	}
-	if !preview {
-		function, err := p.supabase.UpdateFunctionWithBodyWithResponse(ctx, projectId, slug, &params, "application/json", strings.NewReader(((*outputs)["body"]).(string)))
-		if err := checkForSupabaseError(function.HTTPResponse, err); err != nil {
-			return err
-		}
-		if err := structToOutputs(function.JSON200, outputs); err != nil {
-			return err
-		}
-		return nil
+	if preview {
+		return &functionState{ProjectId: args.ProjectId, Name: args.Name, Slug: args.Slug, VerifyJwt: args.VerifyJwt}, nil
 	}
-	if err := structToOutputs(client.FunctionResponse{Name: *params.Name, Slug: slug, VerifyJwt: params.VerifyJwt}, outputs); err != nil {
-		return err
+	if bundle, err := functionBundleOf(inputs); err != nil {
+		return nil, err
+	} else if bundle != nil {
+		return p.deployFunction(ctx, args.ProjectId, args.Slug, bundle, &args.Name, args.VerifyJwt)
 	}
-	return nil
+	function, err := p.supabase.UpdateFunctionWithResponse(withRetrySafe(ctx), args.ProjectId, args.Slug, &client.UpdateFunctionParams{}, client.UpdateFunctionJSONRequestBody{
+		Body:      args.Body,
+		Name:      &args.Name,
+		VerifyJwt: args.VerifyJwt,
+	})
+	if err := checkForSupabaseError(function, err); err != nil {
+		return nil, err
+	}
+	if function.JSON200 == nil {
+		return nil, errUnexpectedResponse(function)
+	}
+	state, err := newFunctionState(args.ProjectId, function.Body)
+	if err != nil {
+		return nil, err
+	}
+	state.BodyHash = functionBodyHash(inputs)
+	return state, nil
 }
 
 func (p *supabaseProvider) deleteFunction(ctx context.Context, projectId, slug string) error {
 	function, err := p.supabase.DeleteFunctionWithResponse(ctx, projectId, slug)
-	return checkForSupabaseError(function.HTTPResponse, err)
+	return checkForSupabaseError(function, err)
+}
+
+// deployFunction uploads the bundle of a function, creating the function if it does not exist yet
+func (p *supabaseProvider) deployFunction(ctx context.Context, projectId, slug string, bundle *functionBundle, name *string, verifyJwt *bool) (*functionState, error) {
+	contentType, body, err := bundle.multipart(client.DeployFunctionMetadata{Name: name, VerifyJwt: verifyJwt})
+	if err != nil {
+		return nil, err
+	}
+	// Deploying the same bundle twice is harmless, the call is retried like an update
+	function, err := p.supabase.DeployFunctionWithBodyWithResponse(withRetrySafe(ctx), projectId, &client.DeployFunctionParams{Slug: slug}, contentType, body)
+	if err := checkForSupabaseError(function, err); err != nil {
+		return nil, err
+	}
+	if function.JSON201 == nil {
+		return nil, errUnexpectedResponse(function)
+	}
+	state, err := newFunctionState(projectId, function.Body)
+	if err != nil {
+		return nil, err
+	}
+	state.SourceHash = bundle.hash()
+	return state, nil
+}
+
+// functionBundleOf bundles a function deployed from its source or with an import map, it returns nil for a plain body
+func functionBundleOf(inputs resource.PropertyMap) (*functionBundle, error) {
+	options := functionBundleOptions{}
+	if err := propertiesMapToStruct(inputs, &options); err != nil {
+		return nil, err
+	}
+	if source, ok := functionSource(inputs); ok {
+		return bundleFunction(source, options)
+	}
+	if options == (functionBundleOptions{}) {
+		return nil, nil
+	}
+	body, ok := inputs["body"]
+	if ok && body.IsSecret() {
+		body = body.SecretValue().Element
+	}
+	if !ok || !body.IsString() {
+		return nil, fmt.Errorf("function body or source is required")
+	}
+	return bundleBody(body.StringValue(), options)
+}
+
+// functionSource returns the local path a function is deployed from, if any
+func functionSource(inputs resource.PropertyMap) (string, bool) {
+	source, ok := inputs["source"]
+	if !ok || !source.IsString() || source.StringValue() == "" {
+		return "", false
+	}
+	return source.StringValue(), true
 }
 
-func (p *supabaseProvider) diffFunction(ctx context.Context, diff *resource.ObjectDiff) ([]string, bool) {
-	changes := []string{}
-	recreate := false
-	for _, key := range diff.ChangedKeys() {
-		if key == "name" || key == "body" || key == "verify_jwt" {
-			changes = append(changes, string(key))
+// checkFunction validates the slug, the code and the import map and paths a function is bundled with
+func checkFunction(inputs resource.PropertyMap) []*pulumirpc.CheckFailure {
+	failures := checkNotEmpty(inputs, "name", "body", "source")
+	if slug, ok := stringInput(inputs, "slug"); ok && !functionSlugPattern.MatchString(slug) {
+		failures = append(failures, checkFailure("slug", "'%s' is not a valid slug, expected letters, digits, dashes and underscores starting with a letter", slug))
+	}
+	switch hasBody := inputs.HasValue("body"); {
+	case hasBody && inputs.HasValue("source"):
+		failures = append(failures, checkFailure("source", "body and source are mutually exclusive"))
+	case !hasBody && !inputs.HasValue("source"):
+		failures = append(failures, checkFailure("body", "either body or source is required"))
+	}
+	for _, key := range []resource.PropertyKey{"entrypointPath", "importMapPath"} {
+		value, ok := inputs[key]
+		if !ok || !value.IsString() {
+			continue
+		}
+		path := filepath.FromSlash(value.StringValue())
+		if path == "" || filepath.IsAbs(path) || filepath.Clean(path) == ".." || strings.HasPrefix(filepath.Clean(path), ".."+string(filepath.Separator)) {
+			failures = append(failures, &pulumirpc.CheckFailure{Property: string(key), Reason: fmt.Sprintf("%s must be a relative path inside the function directory", key)})
+		}
+	}
+	if importMap, ok := inputs["importMap"]; ok && importMap.IsString() {
+		if _, err := readImportMap(importMap.StringValue()); err != nil {
+			failures = append(failures, &pulumirpc.CheckFailure{Property: "importMap", Reason: err.Error()})
 		}
-		if key == "slug" {
-			changes = append(changes, string(key))
-			recreate = true
+	}
+	return failures
+}
+
+// hashFunction sets the hashes Diff compares instead of the body and the bundled files of a function
+func hashFunction(inputs resource.PropertyMap) error {
+	if body, ok := inputs["body"]; ok && body.ContainsUnknowns() {
+		inputs["bodyHash"] = resource.MakeComputed(resource.NewStringProperty(""))
+	} else if hash := functionBodyHash(inputs); hash != "" {
+		inputs["bodyHash"] = resource.NewStringProperty(hash)
+	}
+	for _, key := range []resource.PropertyKey{"source", "importMap", "entrypointPath", "importMapPath"} {
+		if value, ok := inputs[key]; ok && value.ContainsUnknowns() {
+			inputs["sourceHash"] = resource.MakeComputed(resource.NewStringProperty(""))
+			return nil
 		}
 	}
-	return changes, recreate
+	bundle, err := functionBundleOf(inputs)
+	if err != nil || bundle == nil {
+		return err
+	}
+	inputs["sourceHash"] = resource.NewStringProperty(bundle.hash())
+	return nil
+}
+
+func functionBodyHash(inputs resource.PropertyMap) string {
+	body, ok := inputs["body"]
+	if ok && body.IsSecret() {
+		body = body.SecretValue().Element
+	}
+	if !ok || !body.IsString() {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(body.StringValue()))
+	return hex.EncodeToString(hash[:])
 }
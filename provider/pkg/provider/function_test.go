@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const testFunctionUrn = "urn:pulumi:test::test::supabase:index:Function::hello"
+
+// fakeFunction is a function as stored by the fake Management API
+type fakeFunction struct {
+	Slug      string  `json:"slug"`
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	Version   int     `json:"version"`
+	CreatedAt float64 `json:"created_at"`
+	UpdatedAt float64 `json:"updated_at"`
+	VerifyJwt *bool   `json:"verify_jwt,omitempty"`
+	Body      string  `json:"-"`
+}
+
+// fakeFunctionsAPI serves the function endpoints of the Management API for a single project from memory
+type fakeFunctionsAPI struct {
+	mu        sync.Mutex
+	projectId string
+	functions map[string]*fakeFunction
+}
+
+func (f *fakeFunctionsAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer test-token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	prefix := "/v1/projects/" + f.projectId + "/functions"
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	slug := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, prefix), "/")
+	now := float64(time.Now().UnixMilli())
+
+	switch {
+	case r.Method == http.MethodPost && slug == "":
+		body := struct {
+			Slug      string `json:"slug"`
+			Name      string `json:"name"`
+			Body      string `json:"body"`
+			VerifyJwt *bool  `json:"verify_jwt"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Slug == "" || body.Body == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		function := &fakeFunction{Slug: body.Slug, Name: body.Name, Status: "ACTIVE", Version: 1, CreatedAt: now, UpdatedAt: now, VerifyJwt: body.VerifyJwt, Body: body.Body}
+		f.functions[body.Slug] = function
+		writeJSON(w, http.StatusCreated, function)
+	case r.Method == http.MethodGet && f.functions[slug] != nil:
+		writeJSON(w, http.StatusOK, f.functions[slug])
+	case r.Method == http.MethodPatch && f.functions[slug] != nil:
+		body := struct {
+			Name      *string `json:"name"`
+			Body      *string `json:"body"`
+			VerifyJwt *bool   `json:"verify_jwt"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		function := f.functions[slug]
+		if body.Name != nil {
+			function.Name = *body.Name
+		}
+		if body.Body != nil {
+			function.Body = *body.Body
+		}
+		if body.VerifyJwt != nil {
+			function.VerifyJwt = body.VerifyJwt
+		}
+		function.Version++
+		function.UpdatedAt = now
+		writeJSON(w, http.StatusOK, function)
+	case r.Method == http.MethodDelete && f.functions[slug] != nil:
+		delete(f.functions, slug)
+		w.WriteHeader(http.StatusOK)
+	default:
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "Function not found"})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+// newTestProvider configures a provider against the given Management API
+func newTestProvider(t *testing.T, server string) *supabaseProvider {
+	content, err := os.ReadFile("../../../schema.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pulumiSchema, err := yaml.YAMLToJSON(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resourceProvider, err := makeProvider(nil, "supabase", "0.0.0", pulumiSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := resourceProvider.(*supabaseProvider)
+	_, err = p.Configure(context.Background(), &pulumirpc.ConfigureRequest{Variables: map[string]string{
+		"supabase:config:" + configServerKey:               server,
+		"supabase:config:" + configTokenKey:                "test-token",
+		"supabase:config:" + configMaxRetriesKey:           "0",
+		"supabase:config:" + configMaxRequestsPerSecondKey: "0",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func marshalTestProperties(t *testing.T, properties resource.PropertyMap) *structpb.Struct {
+	marshaled, err := plugin.MarshalProperties(properties, plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepSecrets: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return marshaled
+}
+
+func unmarshalTestProperties(t *testing.T, properties *structpb.Struct) resource.PropertyMap {
+	unmarshaled, err := plugin.UnmarshalProperties(properties, plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepSecrets: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return unmarshaled
+}
+
+// checkTestFunction runs Check on the given inputs of a function and returns the inputs to create or update it with
+func checkTestFunction(t *testing.T, p *supabaseProvider, olds *structpb.Struct, news map[string]interface{}) *structpb.Struct {
+	res, err := p.Check(context.Background(), &pulumirpc.CheckRequest{Urn: testFunctionUrn, Olds: olds, News: marshalTestProperties(t, resource.NewPropertyMapFromMap(news))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.GetFailures()) > 0 {
+		t.Fatalf("unexpected check failures: %v", res.GetFailures())
+	}
+	return res.GetInputs()
+}
+
+func TestFunctionLifecycle(t *testing.T) {
+	api := &fakeFunctionsAPI{projectId: "test-project", functions: map[string]*fakeFunction{}}
+	server := httptest.NewServer(api)
+	defer server.Close()
+	p := newTestProvider(t, server.URL)
+	ctx := context.Background()
+
+	// Create deploys the body read from the inputs
+	inputs := checkTestFunction(t, p, nil, map[string]interface{}{
+		"projectId": "test-project",
+		"name":      "Hello",
+		"slug":      "hello",
+		"body":      "Deno.serve(() => new Response('hello'))",
+	})
+	created, err := p.Create(ctx, &pulumirpc.CreateRequest{Urn: testFunctionUrn, Properties: inputs})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.GetId() != "test-project/hello" {
+		t.Errorf("id = %q, expected test-project/hello", created.GetId())
+	}
+	if api.functions["hello"] == nil || api.functions["hello"].Body != "Deno.serve(() => new Response('hello'))" {
+		t.Fatalf("function not deployed with its body: %+v", api.functions["hello"])
+	}
+	outputs := unmarshalTestProperties(t, created.GetProperties())
+	if outputs.HasValue("body") {
+		t.Errorf("the body should not be stored in the outputs")
+	}
+	if !outputs["bodyHash"].IsString() || outputs["bodyHash"].StringValue() != unmarshalTestProperties(t, inputs)["bodyHash"].StringValue() {
+		t.Errorf("bodyHash = %v, expected the hash computed by Check", outputs["bodyHash"])
+	}
+
+	// Update deploys the new body once Diff sees its hash change
+	inputs = checkTestFunction(t, p, created.GetProperties(), map[string]interface{}{
+		"projectId": "test-project",
+		"name":      "Hello",
+		"slug":      "hello",
+		"body":      "Deno.serve(() => new Response('hello again'))",
+	})
+	diff, err := p.Diff(ctx, &pulumirpc.DiffRequest{Urn: testFunctionUrn, Id: created.GetId(), Olds: created.GetProperties(), News: inputs})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.GetChanges() != pulumirpc.DiffResponse_DIFF_SOME || len(diff.GetReplaces()) > 0 || diff.GetDetailedDiff()["bodyHash"] == nil {
+		t.Fatalf("expected an in place update of bodyHash, got %v", diff)
+	}
+	updated, err := p.Update(ctx, &pulumirpc.UpdateRequest{Urn: testFunctionUrn, Id: created.GetId(), Olds: created.GetProperties(), News: inputs})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if api.functions["hello"].Body != "Deno.serve(() => new Response('hello again'))" {
+		t.Errorf("function body not updated: %q", api.functions["hello"].Body)
+	}
+	if version := unmarshalTestProperties(t, updated.GetProperties())["version"]; !version.IsNumber() || version.NumberValue() != 2 {
+		t.Errorf("version = %v, expected 2", version)
+	}
+
+	// Read reports the deployed function and keeps the known hash
+	read, err := p.Read(ctx, &pulumirpc.ReadRequest{Urn: testFunctionUrn, Id: created.GetId(), Properties: updated.GetProperties()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.GetId() != "test-project/hello" {
+		t.Errorf("read id = %q, expected test-project/hello", read.GetId())
+	}
+	state := unmarshalTestProperties(t, read.GetProperties())
+	if state["bodyHash"] != unmarshalTestProperties(t, updated.GetProperties())["bodyHash"] {
+		t.Errorf("read bodyHash = %v, expected the one of the update", state["bodyHash"])
+	}
+	if slug := unmarshalTestProperties(t, read.GetInputs())["slug"]; !slug.IsString() || slug.StringValue() != "hello" {
+		t.Errorf("read inputs slug = %v, expected hello", slug)
+	}
+
+	// Delete removes the function, which Read then reports as gone
+	if _, err := p.Delete(ctx, &pulumirpc.DeleteRequest{Urn: testFunctionUrn, Id: created.GetId(), Properties: read.GetProperties()}); err != nil {
+		t.Fatal(err)
+	}
+	if len(api.functions) != 0 {
+		t.Errorf("function not deleted: %v", api.functions)
+	}
+	gone, err := p.Read(ctx, &pulumirpc.ReadRequest{Urn: testFunctionUrn, Id: created.GetId(), Properties: read.GetProperties()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gone.GetId() != "" {
+		t.Errorf("deleted function read with id %q", gone.GetId())
+	}
+}
+
+func TestFunctionImport(t *testing.T) {
+	api := &fakeFunctionsAPI{projectId: "test-project", functions: map[string]*fakeFunction{
+		"hello": {Slug: "hello", Name: "Hello", Status: "ACTIVE", Version: 3, Body: "export {}"},
+	}}
+	server := httptest.NewServer(api)
+	defer server.Close()
+	p := newTestProvider(t, server.URL)
+
+	read, err := p.Read(context.Background(), &pulumirpc.ReadRequest{Urn: testFunctionUrn, Id: "test-project/hello"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	inputs := unmarshalTestProperties(t, read.GetInputs())
+	if inputs["projectId"].StringValue() != "test-project" || inputs["slug"].StringValue() != "hello" || inputs["name"].StringValue() != "Hello" {
+		t.Errorf("unexpected imported inputs: %v", inputs)
+	}
+}
+
+func TestFunctionPreviewWithUnknownProject(t *testing.T) {
+	api := &fakeFunctionsAPI{projectId: "test-project", functions: map[string]*fakeFunction{}}
+	server := httptest.NewServer(api)
+	defer server.Close()
+	p := newTestProvider(t, server.URL)
+
+	// The project of the function is not created yet, its ID is unknown during the preview
+	inputs := checkTestFunction(t, p, nil, map[string]interface{}{
+		"projectId": resource.Computed{Element: resource.NewStringProperty("")},
+		"name":      "Hello",
+		"slug":      "hello",
+		"body":      "Deno.serve(() => new Response('hello'))",
+	})
+	created, err := p.Create(context.Background(), &pulumirpc.CreateRequest{Urn: testFunctionUrn, Properties: inputs, Preview: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.GetId() != "" {
+		t.Errorf("preview id = %q, expected none", created.GetId())
+	}
+	if projectId := unmarshalTestProperties(t, created.GetProperties())["projectId"]; !projectId.IsComputed() {
+		t.Errorf("projectId = %v, expected it to stay unknown", projectId)
+	}
+	if len(api.functions) != 0 {
+		t.Errorf("function deployed during preview: %v", api.functions)
+	}
+}
+
+func TestFunctionOlderState(t *testing.T) {
+	api := &fakeFunctionsAPI{projectId: "test-project", functions: map[string]*fakeFunction{
+		"hello": {Slug: "hello", Name: "Hello", Status: "ACTIVE", Version: 1, Body: "export {}"},
+	}}
+	server := httptest.NewServer(api)
+	defer server.Close()
+	p := newTestProvider(t, server.URL)
+	ctx := context.Background()
+
+	// Older states have the ID of the function and no projectId in their outputs
+	const olderId = "6f3ab1c2-6c1d-4e4e-9a47-0d1b1bd0c6a1"
+	olds := marshalTestProperties(t, resource.NewPropertyMapFromMap(map[string]interface{}{
+		"name":     "Hello",
+		"slug":     "hello",
+		"status":   "ACTIVE",
+		"version":  1,
+		"bodyHash": "0000",
+	}))
+	if _, err := p.Delete(ctx, &pulumirpc.DeleteRequest{Urn: testFunctionUrn, Id: olderId, Properties: olds}); err == nil {
+		t.Errorf("expected an error deleting a function whose project is unknown")
+	}
+
+	inputs := checkTestFunction(t, p, nil, map[string]interface{}{
+		"projectId": "test-project",
+		"name":      "Hello",
+		"slug":      "hello",
+		"body":      "export default {}",
+	})
+	diff, err := p.Diff(ctx, &pulumirpc.DiffRequest{Urn: testFunctionUrn, Id: olderId, Olds: olds, News: inputs})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diff.GetReplaces()) > 0 {
+		t.Fatalf("expected an in place update, got replaces %v", diff.GetReplaces())
+	}
+	updated, err := p.Update(ctx, &pulumirpc.UpdateRequest{Urn: testFunctionUrn, Id: olderId, Olds: olds, News: inputs})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if api.functions["hello"].Body != "export default {}" {
+		t.Errorf("function body not updated: %q", api.functions["hello"].Body)
+	}
+
+	// The update records the project, the function can then be deleted
+	if _, err := p.Delete(ctx, &pulumirpc.DeleteRequest{Urn: testFunctionUrn, Id: olderId, Properties: updated.GetProperties()}); err != nil {
+		t.Fatal(err)
+	}
+	if len(api.functions) != 0 {
+		t.Errorf("function not deleted: %v", api.functions)
+	}
+}
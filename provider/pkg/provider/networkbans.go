@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/LuxChanLu/pulumi-supabase/pkg/provider/client"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+// networkBans are the outputs of the getNetworkBans function
+type networkBans struct {
+	ProjectId           string   `json:"projectId"`
+	BannedIpv4Addresses []string `json:"bannedIpv4Addresses"`
+}
+
+// networkBanRemovalArgs are the inputs of a network ban removal
+type networkBanRemovalArgs struct {
+	ProjectId     string        `json:"projectId"`
+	Ipv4Addresses []string      `json:"ipv4Addresses"`
+	Triggers      []interface{} `json:"triggers,omitempty"`
+}
+
+// networkBanRemovalState are the outputs of a network ban removal
+type networkBanRemovalState struct {
+	networkBanRemovalArgs
+	// UnbannedIpv4Addresses are the addresses which were banned when the bans were last lifted
+	UnbannedIpv4Addresses []string `json:"unbannedIpv4Addresses"`
+}
+
+func checkNetworkBanRemoval(inputs resource.PropertyMap) []*pulumirpc.CheckFailure {
+	if inputs["ipv4Addresses"].ContainsUnknowns() {
+		return nil
+	}
+	args := networkBanRemovalArgs{}
+	if err := propertiesMapToStruct(inputs, &args); err != nil {
+		return []*pulumirpc.CheckFailure{checkFailure("", "%s", err)}
+	}
+	failures := []*pulumirpc.CheckFailure{}
+	for i, address := range args.Ipv4Addresses {
+		if ip, err := netip.ParseAddr(address); err != nil || !ip.Is4() {
+			failures = append(failures, checkFailure(resource.PropertyPath{"ipv4Addresses", i}, "%s is not a valid IPv4 address", address))
+		}
+	}
+	return failures
+}
+
+func (p *supabaseProvider) getNetworkBans(ctx context.Context, projectId string) (*networkBans, error) {
+	res, err := p.supabase.GetNetworkBansWithResponse(withRetrySafe(ctx), projectId)
+	if err := checkForSupabaseError(res, err); err != nil {
+		return nil, err
+	}
+	if res.JSON201 == nil {
+		return nil, errUnexpectedResponse(res)
+	}
+	bans := &networkBans{ProjectId: projectId, BannedIpv4Addresses: res.JSON201.BannedIpv4Addresses}
+	if bans.BannedIpv4Addresses == nil {
+		bans.BannedIpv4Addresses = []string{}
+	}
+	return bans, nil
+}
+
+func (p *supabaseProvider) createNetworkBanRemoval(ctx context.Context, inputs resource.PropertyMap, preview bool) (string, *networkBanRemovalState, error) {
+	state, err := p.updateNetworkBanRemoval(ctx, inputs, preview)
+	if err != nil || preview {
+		return "", state, err
+	}
+	return state.ProjectId, state, nil
+}
+
+// readNetworkBanRemoval returns the known state, bans come and go so there is no drift to detect
+func (p *supabaseProvider) readNetworkBanRemoval(id string, state resource.PropertyMap) (string, *networkBanRemovalState, error) {
+	known := networkBanRemovalState{}
+	if err := propertiesMapToStruct(state, &known); err != nil {
+		return "", nil, err
+	}
+	if known.ProjectId == "" {
+		known.ProjectId = id
+	}
+	if known.Ipv4Addresses == nil {
+		known.Ipv4Addresses = []string{}
+	}
+	if known.UnbannedIpv4Addresses == nil {
+		known.UnbannedIpv4Addresses = []string{}
+	}
+	return id, &known, nil
+}
+
+// updateNetworkBanRemoval lifts the bans of the given addresses, it runs again whenever the addresses or the triggers change
+func (p *supabaseProvider) updateNetworkBanRemoval(ctx context.Context, inputs resource.PropertyMap, preview bool) (*networkBanRemovalState, error) {
+	args := networkBanRemovalArgs{}
+	if err := propertiesMapToStruct(inputs, &args); err != nil {
+		return nil, err
+	}
+	state := &networkBanRemovalState{networkBanRemovalArgs: args, UnbannedIpv4Addresses: []string{}}
+	if preview || len(args.Ipv4Addresses) == 0 {
+		return state, nil
+	}
+
+	bans, err := p.getNetworkBans(ctx, args.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	for _, address := range args.Ipv4Addresses {
+		if containsString(bans.BannedIpv4Addresses, address) {
+			state.UnbannedIpv4Addresses = append(state.UnbannedIpv4Addresses, address)
+		}
+	}
+	if len(state.UnbannedIpv4Addresses) == 0 {
+		return state, nil
+	}
+	res, err := p.supabase.RemoveNetworkBanWithResponse(withRetrySafe(ctx), args.ProjectId, client.RemoveNetworkBanJSONRequestBody{Ipv4Addresses: state.UnbannedIpv4Addresses})
+	if err := checkForSupabaseError(res, err); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/LuxChanLu/pulumi-supabase/pkg/provider/client"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+// allowAllCidrs are the restrictions of a new project, restored when the resource is deleted
+var allowAllCidrs = []string{"0.0.0.0/0", "::/0"}
+
+// networkRestrictionsArgs are the inputs of the network restrictions of a project
+type networkRestrictionsArgs struct {
+	ProjectId      string   `json:"projectId"`
+	DbAllowedCidrs []string `json:"dbAllowedCidrs"`
+}
+
+// networkRestrictionsState are the outputs of the network restrictions of a project
+type networkRestrictionsState struct {
+	networkRestrictionsArgs
+	Entitlement string `json:"entitlement,omitempty"`
+	Status      string `json:"status,omitempty"`
+}
+
+func checkNetworkRestrictions(inputs resource.PropertyMap) []*pulumirpc.CheckFailure {
+	if inputs["dbAllowedCidrs"].ContainsUnknowns() {
+		return nil
+	}
+	args := networkRestrictionsArgs{}
+	if err := propertiesMapToStruct(inputs, &args); err != nil {
+		return []*pulumirpc.CheckFailure{checkFailure("", "%s", err)}
+	}
+	failures := []*pulumirpc.CheckFailure{}
+	prefixes := map[int]netip.Prefix{}
+	for i, cidr := range args.DbAllowedCidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			failures = append(failures, checkFailure(resource.PropertyPath{"dbAllowedCidrs", i}, "%s is not a valid IPv4 or IPv6 CIDR", cidr))
+			continue
+		}
+		if masked := prefix.Masked(); masked != prefix {
+			failures = append(failures, checkFailure(resource.PropertyPath{"dbAllowedCidrs", i}, "%s has host bits set, did you mean %s?", cidr, masked))
+			continue
+		}
+		for j := 0; j < i; j++ {
+			if other, ok := prefixes[j]; ok && other.Overlaps(prefix) {
+				failures = append(failures, checkFailure(resource.PropertyPath{"dbAllowedCidrs", i}, "%s overlaps %s", cidr, other))
+				break
+			}
+		}
+		prefixes[i] = prefix
+	}
+	return failures
+}
+
+func (p *supabaseProvider) createNetworkRestrictions(ctx context.Context, inputs resource.PropertyMap, preview bool) (string, *networkRestrictionsState, error) {
+	args := networkRestrictionsArgs{}
+	if err := propertiesMapToStruct(inputs, &args); err != nil {
+		return "", nil, err
+	}
+	if preview {
+		return "", &networkRestrictionsState{networkRestrictionsArgs: args}, nil
+	}
+	state, err := p.applyNetworkRestrictions(ctx, args.ProjectId, args.DbAllowedCidrs)
+	if err != nil {
+		return "", nil, err
+	}
+	return args.ProjectId, state, nil
+}
+
+func (p *supabaseProvider) readNetworkRestrictions(ctx context.Context, projectId string, state resource.PropertyMap) (string, *networkRestrictionsState, error) {
+	known := networkRestrictionsState{}
+	if err := propertiesMapToStruct(state, &known); err != nil {
+		return "", nil, err
+	}
+	res, err := p.supabase.GetNetworkRestrictionsWithResponse(ctx, projectId)
+	if err := checkForSupabaseError(res, err); err != nil {
+		if isNotFound(err) {
+			return "", nil, nil
+		}
+		return "", nil, err
+	}
+	if res.JSON200 == nil {
+		return "", nil, errUnexpectedResponse(res)
+	}
+	return projectId, newNetworkRestrictionsState(projectId, *res.JSON200, known.DbAllowedCidrs), nil
+}
+
+func (p *supabaseProvider) updateNetworkRestrictions(ctx context.Context, inputs resource.PropertyMap, preview bool) (*networkRestrictionsState, error) {
+	args := networkRestrictionsArgs{}
+	if err := propertiesMapToStruct(inputs, &args); err != nil {
+		return nil, err
+	}
+	if preview {
+		return &networkRestrictionsState{networkRestrictionsArgs: args}, nil
+	}
+	return p.applyNetworkRestrictions(ctx, args.ProjectId, args.DbAllowedCidrs)
+}
+
+// deleteNetworkRestrictions allows every address again, the restrictions themselves cannot be deleted
+func (p *supabaseProvider) deleteNetworkRestrictions(ctx context.Context, projectId string) error {
+	_, err := p.applyNetworkRestrictions(ctx, projectId, allowAllCidrs)
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (p *supabaseProvider) applyNetworkRestrictions(ctx context.Context, projectId string, cidrs []string) (*networkRestrictionsState, error) {
+	res, err := p.supabase.ApplyNetworkRestrictionsWithResponse(withRetrySafe(ctx), projectId, client.ApplyNetworkRestrictionsJSONRequestBody{DbAllowedCidrs: cidrs})
+	if err := checkForSupabaseError(res, err); err != nil {
+		return nil, err
+	}
+	if res.JSON201 == nil {
+		return nil, errUnexpectedResponse(res)
+	}
+	return newNetworkRestrictionsState(projectId, *res.JSON201, cidrs), nil
+}
+
+// newNetworkRestrictionsState keeps the order of the known CIDRs when the API returns the same set in another order
+func newNetworkRestrictionsState(projectId string, restrictions client.NetworkRestrictionsResponse, known []string) *networkRestrictionsState {
+	cidrs := restrictions.Config.DbAllowedCidrs
+	if sameStrings(cidrs, known) {
+		cidrs = known
+	}
+	if cidrs == nil {
+		cidrs = []string{}
+	}
+	return &networkRestrictionsState{
+		networkRestrictionsArgs: networkRestrictionsArgs{ProjectId: projectId, DbAllowedCidrs: cidrs},
+		Entitlement:             string(restrictions.Entitlement),
+		Status:                  string(restrictions.Status),
+	}
+}
+
+// sameStrings is true when both slices hold the same values, whatever their order
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := map[string]int{}
+	for _, value := range a {
+		counts[value]++
+	}
+	for _, value := range b {
+		if counts[value]--; counts[value] < 0 {
+			return false
+		}
+	}
+	return true
+}
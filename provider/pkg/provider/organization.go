@@ -5,52 +5,57 @@ import (
 
 	"github.com/LuxChanLu/pulumi-supabase/pkg/provider/client"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
 )
 
-func (p *supabaseProvider) createOrganization(ctx context.Context, inputs resource.PropertyMap, preview bool, outputs *map[string]interface{}) (string, error) {
-	body := client.CreateOrganizationJSONRequestBody{}
-	if err := propertiesMapToStruct(inputs, &body); err != nil {
-		return "", err
+// organizationArgs are the inputs of an organization
+type organizationArgs struct {
+	Name string `json:"name"`
+}
+
+// organizationState are the outputs of an organization
+type organizationState struct {
+	Name string `json:"name"`
+}
+
+func checkOrganization(inputs resource.PropertyMap) []*pulumirpc.CheckFailure {
+	return checkNotEmpty(inputs, "name")
+}
+
+func newOrganizationState(organization client.OrganizationResponse) *organizationState {
+	return &organizationState{Name: organization.Name}
+}
+
+func (p *supabaseProvider) createOrganization(ctx context.Context, inputs resource.PropertyMap, preview bool) (string, *organizationState, error) {
+	args := organizationArgs{}
+	if err := propertiesMapToStruct(inputs, &args); err != nil {
+		return "", nil, err
 	}
-	if !preview {
-		organization, err := p.supabase.CreateOrganizationWithResponse(ctx, body)
-		if err := checkForSupabaseError(organization.HTTPResponse, err); err != nil {
-			return "", err
-		}
-		if err := structToOutputs(organization.JSON201, outputs); err != nil {
-			return "", err
-		}
-		return organization.JSON201.Id, nil
+	if preview {
+		return "", &organizationState{Name: args.Name}, nil
 	}
-	if err := structToOutputs(client.OrganizationResponse{Name: body.Name}, outputs); err != nil {
-		return "", err
+	organization, err := p.supabase.CreateOrganizationWithResponse(ctx, client.CreateOrganizationJSONRequestBody{Name: args.Name})
+	if err := checkForSupabaseError(organization, err); err != nil {
+		return "", nil, err
 	}
-	return "", nil
+	if organization.JSON201 == nil {
+		return "", nil, errUnexpectedResponse(organization)
+	}
+	return organization.JSON201.Id, newOrganizationState(*organization.JSON201), nil
 }
 
-func (p *supabaseProvider) readOrganization(ctx context.Context, id string, outputs *map[string]interface{}) (string, error) {
+func (p *supabaseProvider) readOrganization(ctx context.Context, id string) (string, *organizationState, error) {
 	organizations, err := p.supabase.GetOrganizationsWithResponse(ctx)
-	if err != nil || organizations.JSON200 == nil {
-		return "", err
+	if err := checkForSupabaseError(organizations, err); err != nil {
+		return "", nil, err
+	}
+	if organizations.JSON200 == nil {
+		return "", nil, errUnexpectedResponse(organizations)
 	}
 	for _, organization := range *organizations.JSON200 {
 		if organization.Id == id {
-			if err := structToOutputs(organization, outputs); err != nil {
-				return "", err
-			}
-			return organization.Id, nil
-		}
-	}
-	return "", nil
-}
-
-func (p *supabaseProvider) diffOrganization(ctx context.Context, diff *resource.ObjectDiff) ([]string, bool) {
-	changes := []string{}
-	recreate := false
-	for _, key := range diff.ChangedKeys() {
-		if key == "name" {
-			changes = append(changes, string(key))
+			return organization.Id, newOrganizationState(organization), nil
 		}
 	}
-	return changes, recreate
+	return "", nil, nil
 }
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/LuxChanLu/pulumi-supabase/pkg/provider/client"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+// The PostgREST settings of a new project, restored when the resource is deleted
+var defaultPostgrestDbSchema = []string{"public", "storage", "graphql_public"}
+var defaultPostgrestDbExtraSearchPath = []string{"public", "extensions"}
+
+const defaultPostgrestMaxRows = 1000
+
+// postgrestConfig are the inputs and the outputs of the PostgREST configuration of a project, unset settings are left as is
+type postgrestConfig struct {
+	ProjectId         string    `json:"projectId"`
+	DbSchema          *[]string `json:"dbSchema,omitempty"`
+	DbExtraSearchPath *[]string `json:"dbExtraSearchPath,omitempty"`
+	MaxRows           *int      `json:"maxRows,omitempty"`
+}
+
+func checkPostgrestConfig(inputs resource.PropertyMap) []*pulumirpc.CheckFailure {
+	config := postgrestConfig{}
+	if err := propertiesMapToStruct(inputs, &config); err != nil {
+		return []*pulumirpc.CheckFailure{checkFailure("", "%s", err)}
+	}
+	failures := []*pulumirpc.CheckFailure{}
+	for key, schemas := range map[resource.PropertyKey]*[]string{"dbSchema": config.DbSchema, "dbExtraSearchPath": config.DbExtraSearchPath} {
+		if schemas == nil || inputs[key].ContainsUnknowns() {
+			continue
+		}
+		for i, schema := range *schemas {
+			if strings.TrimSpace(schema) == "" || strings.Contains(schema, ",") {
+				failures = append(failures, checkFailure(resource.PropertyPath{string(key), i}, "schema names must not be empty nor contain a comma"))
+			}
+		}
+	}
+	if config.MaxRows != nil && !inputs["maxRows"].ContainsUnknowns() && *config.MaxRows <= 0 {
+		failures = append(failures, checkFailure("maxRows", "maxRows must be greater than 0"))
+	}
+	return failures
+}
+
+func (p *supabaseProvider) createPostgrestConfig(ctx context.Context, inputs resource.PropertyMap, preview bool) (string, *postgrestConfig, error) {
+	config := postgrestConfig{}
+	if err := propertiesMapToStruct(inputs, &config); err != nil {
+		return "", nil, err
+	}
+	if preview {
+		return "", &config, nil
+	}
+	if err := p.applyPostgrestConfig(ctx, config); err != nil {
+		return "", nil, err
+	}
+	return config.ProjectId, &config, nil
+}
+
+func (p *supabaseProvider) readPostgrestConfig(ctx context.Context, projectId string, state resource.PropertyMap) (string, *postgrestConfig, error) {
+	known := postgrestConfig{}
+	if err := propertiesMapToStruct(state, &known); err != nil {
+		return "", nil, err
+	}
+	res, err := p.supabase.GetPostgRESTConfigWithResponse(ctx, projectId)
+	if err := checkForSupabaseError(res, err); err != nil {
+		if isNotFound(err) {
+			return "", nil, nil
+		}
+		return "", nil, err
+	}
+	if res.JSON200 == nil {
+		return "", nil, errUnexpectedResponse(res)
+	}
+
+	// A setting left unset is kept at the project's value and only read back once set, or when the config is imported
+	importing := len(state) == 0
+	config := &postgrestConfig{ProjectId: projectId}
+	if known.DbSchema != nil || importing {
+		dbSchema := splitSchemas(res.JSON200.DbSchema)
+		config.DbSchema = &dbSchema
+	}
+	if known.DbExtraSearchPath != nil || importing {
+		dbExtraSearchPath := splitSchemas(res.JSON200.DbExtraSearchPath)
+		config.DbExtraSearchPath = &dbExtraSearchPath
+	}
+	if known.MaxRows != nil || importing {
+		config.MaxRows = &res.JSON200.MaxRows
+	}
+	return projectId, config, nil
+}
+
+func (p *supabaseProvider) updatePostgrestConfig(ctx context.Context, inputs resource.PropertyMap, preview bool) (*postgrestConfig, error) {
+	config := postgrestConfig{}
+	if err := propertiesMapToStruct(inputs, &config); err != nil {
+		return nil, err
+	}
+	if !preview {
+		if err := p.applyPostgrestConfig(ctx, config); err != nil {
+			return nil, err
+		}
+	}
+	return &config, nil
+}
+
+// deletePostgrestConfig restores the settings of a new project, the configuration itself cannot be deleted
+func (p *supabaseProvider) deletePostgrestConfig(ctx context.Context, projectId string) error {
+	dbSchema, dbExtraSearchPath, maxRows := defaultPostgrestDbSchema, defaultPostgrestDbExtraSearchPath, defaultPostgrestMaxRows
+	err := p.applyPostgrestConfig(ctx, postgrestConfig{ProjectId: projectId, DbSchema: &dbSchema, DbExtraSearchPath: &dbExtraSearchPath, MaxRows: &maxRows})
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (p *supabaseProvider) applyPostgrestConfig(ctx context.Context, config postgrestConfig) error {
+	body := client.UpdatePostgRESTConfigJSONRequestBody{MaxRows: config.MaxRows}
+	if config.DbSchema != nil {
+		dbSchema := strings.Join(*config.DbSchema, ",")
+		body.DbSchema = &dbSchema
+	}
+	if config.DbExtraSearchPath != nil {
+		dbExtraSearchPath := strings.Join(*config.DbExtraSearchPath, ",")
+		body.DbExtraSearchPath = &dbExtraSearchPath
+	}
+	if body == (client.UpdatePostgRESTConfigJSONRequestBody{}) {
+		return nil
+	}
+	res, err := p.supabase.UpdatePostgRESTConfigWithResponse(withRetrySafe(ctx), config.ProjectId, body)
+	return checkForSupabaseError(res, err)
+}
+
+// splitSchemas reads the comma separated schema lists of PostgREST
+func splitSchemas(value string) []string {
+	schemas := []string{}
+	for _, schema := range strings.Split(value, ",") {
+		if schema = strings.TrimSpace(schema); schema != "" {
+			schemas = append(schemas, schema)
+		}
+	}
+	return schemas
+}
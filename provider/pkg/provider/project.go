@@ -3,73 +3,228 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/LuxChanLu/pulumi-supabase/pkg/provider/client"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func (p *supabaseProvider) createProject(ctx context.Context, inputs resource.PropertyMap, preview bool, outputs *map[string]interface{}) (string, error) {
-	body := client.CreateProjectJSONRequestBody{}
-	if err := propertiesMapToStruct(inputs, &body); err != nil {
-		return "", err
-	}
-	if !preview {
-		project, err := p.supabase.CreateProjectWithResponse(ctx, body)
-		if err := checkForSupabaseError(project.HTTPResponse, err); err != nil {
-			return "", err
-		}
-		if err := structToOutputs(project.JSON201, outputs); err != nil {
-			return "", err
-		}
-		decorateProject(project.JSON201, *outputs)
-		return project.JSON201.Id, nil
+const defaultProjectCreateTimeout = 20 * time.Minute
+const projectPollMinInterval = 5 * time.Second
+const projectPollMaxInterval = 30 * time.Second
+
+// projectArgs are the inputs of a project
+type projectArgs struct {
+	Name               string                         `json:"name"`
+	OrganizationId     string                         `json:"organization_id"`
+	DbPass             string                         `json:"db_pass"`
+	Plan               client.CreateProjectBodyPlan   `json:"plan"`
+	Region             client.CreateProjectBodyRegion `json:"region"`
+	KpsEnabled         *bool                          `json:"kps_enabled,omitempty"`
+	DeletionProtection *bool                          `json:"deletionProtection,omitempty"`
+}
+
+// projectState are the outputs of a project, the inputs the API does not return are kept by Diff. The outputs only
+// known once the project is created are left out of the preview so they stay unknown
+type projectState struct {
+	Name           string `json:"name"`
+	OrganizationId string `json:"organization_id"`
+	Region         string `json:"region"`
+	Plan           string `json:"plan,omitempty"`
+	Status         string `json:"status,omitempty"`
+	CreatedAt      string `json:"created_at,omitempty"`
+	DbUsername     string `json:"dbUsername,omitempty"`
+	DbHost         string `json:"dbHost,omitempty"`
+	DbPort         int    `json:"dbPort,omitempty"`
+	DbName         string `json:"dbName,omitempty"`
+	DbPoolingPort  int    `json:"dbPoolingPort,omitempty"`
+	Endpoint       string `json:"endpoint,omitempty"`
+}
+
+func checkProject(inputs resource.PropertyMap) []*pulumirpc.CheckFailure {
+	return checkNotEmpty(inputs, "name", "organization_id", "db_pass")
+}
+
+// TODO: Database settings from api when available
+func newProjectState(project client.ProjectResponse) *projectState {
+	state := &projectState{
+		Name:           project.Name,
+		OrganizationId: project.OrganizationId,
+		Region:         project.Region,
+		CreatedAt:      project.CreatedAt,
+		DbUsername:     "postgres",
+		DbHost:         fmt.Sprintf("db.%s.supabase.co", project.Id),
+		DbPort:         5432,
+		DbName:         "postgres",
+		DbPoolingPort:  6543,
+		Endpoint:       fmt.Sprintf("https://%s.supabase.co", project.Id),
 	}
-	if err := structToOutputs(client.ProjectResponse{Name: body.Name, Region: string(body.Region), OrganizationId: body.OrganizationId}, outputs); err != nil {
-		return "", err
+	if project.Database != nil && project.Database.Host != "" {
+		state.DbHost = project.Database.Host
 	}
-	return "", nil
+	return state
 }
 
-func (p *supabaseProvider) readProject(ctx context.Context, id string, outputs *map[string]interface{}) (string, error) {
-	projects, err := p.supabase.GetProjectsWithResponse(ctx)
-	if err != nil || projects.JSON200 == nil {
-		return "", err
-	}
-	for _, project := range *projects.JSON200 {
-		if project.Id == id {
-			if err := structToOutputs(project, outputs); err != nil {
-				return "", err
-			}
-			decorateProject(&project, *outputs)
-			return project.Id, nil
-		}
+func (p *supabaseProvider) createProject(ctx context.Context, inputs resource.PropertyMap, timeout time.Duration, preview bool) (string, *projectState, error) {
+	args := projectArgs{}
+	if err := propertiesMapToStruct(inputs, &args); err != nil {
+		return "", nil, err
+	}
+	if preview {
+		return "", &projectState{Name: args.Name, OrganizationId: args.OrganizationId, Region: string(args.Region)}, nil
 	}
-	return "", nil
+	project, err := p.supabase.CreateProjectWithResponse(ctx, client.CreateProjectJSONRequestBody{
+		DbPass:         args.DbPass,
+		KpsEnabled:     args.KpsEnabled,
+		Name:           args.Name,
+		OrganizationId: args.OrganizationId,
+		Plan:           args.Plan,
+		Region:         args.Region,
+	})
+	if err := checkForSupabaseError(project, err); err != nil {
+		return "", nil, err
+	}
+	if project.JSON201 == nil {
+		return "", nil, errUnexpectedResponse(project)
+	}
+	state := newProjectState(*project.JSON201)
+	projectStatus, err := p.waitForProject(ctx, project.JSON201.Id, timeout)
+	state.Status = string(projectStatus)
+	return project.JSON201.Id, state, err
 }
 
-// TODO: From api when available
-func decorateProject(project *client.ProjectResponse, outputs map[string]interface{}) {
-	outputs["dbUsername"] = "postgres"
-	outputs["dbHost"] = fmt.Sprintf("db.%s.supabase.co", project.Id)
-	outputs["dbPort"] = 5432
-	outputs["dbName"] = "postgres"
+// waitForProject polls the project with an exponential backoff until it is ACTIVE_HEALTHY and returns the last observed status
+func (p *supabaseProvider) waitForProject(ctx context.Context, id string, timeout time.Duration) (client.ProjectStatus, error) {
+	if timeout <= 0 {
+		timeout = defaultProjectCreateTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	outputs["dbPoolingPort"] = 6543
+	last := client.ProjectStatusUNKNOWN
+	interval := projectPollMinInterval
+	for {
+		project, err := p.supabase.GetProjectWithResponse(ctx, id)
+		if ctx.Err() != nil {
+			return last, fmt.Errorf("project %s did not become %s within %s (last status: %s)", id, client.ProjectStatusACTIVEHEALTHY, timeout, last)
+		}
+		if err := checkForSupabaseError(project, err); err != nil {
+			return last, err
+		}
+		if project.JSON200 != nil {
+			last = project.JSON200.Status
+		}
+		switch last {
+		case client.ProjectStatusACTIVEHEALTHY:
+			return last, nil
+		case client.ProjectStatusINITFAILED, client.ProjectStatusREMOVED:
+			return last, fmt.Errorf("project %s failed to provision (status: %s)", id, last)
+		}
+		select {
+		case <-ctx.Done():
+			return last, fmt.Errorf("project %s did not become %s within %s (last status: %s)", id, client.ProjectStatusACTIVEHEALTHY, timeout, last)
+		case <-time.After(interval):
+		}
+		if interval *= 2; interval > projectPollMaxInterval {
+			interval = projectPollMaxInterval
+		}
+	}
+}
 
-	outputs["endpoint"] = fmt.Sprintf("https://%s.supabase.co", project.Id)
+func (p *supabaseProvider) readProject(ctx context.Context, id string) (string, *projectState, error) {
+	project, err := p.supabase.GetProjectWithResponse(ctx, id)
+	if err := checkForSupabaseError(project, err); err != nil {
+		if isNotFound(err) {
+			return "", nil, nil
+		}
+		return "", nil, err
+	}
+	if project.JSON200 == nil {
+		return "", nil, errUnexpectedResponse(project)
+	}
+	if project.JSON200.Status == client.ProjectStatusREMOVED {
+		return "", nil, nil
+	}
+	state := newProjectState(project.JSON200.ProjectResponse)
+	state.Status = string(project.JSON200.Status)
+	// The plan is only reported by some versions of the API, the one of the inputs is kept otherwise
+	if project.JSON200.Plan != nil {
+		state.Plan = string(*project.JSON200.Plan)
+	}
+	return project.JSON200.Id, state, nil
 }
 
-func (p *supabaseProvider) diffProject(ctx context.Context, diff *resource.ObjectDiff) ([]string, bool) {
-	changes := []string{}
-	recreate := false
-	for _, key := range diff.ChangedKeys() {
-		if key == "db_pass" || key == "name" || key == "organization_id" || key == "kps_enabled" || key == "plan" {
-			changes = append(changes, string(key))
+func (p *supabaseProvider) updateProject(ctx context.Context, id string, olds, news resource.PropertyMap, timeout time.Duration, preview bool) (*projectState, error) {
+	previous, args := projectArgs{}, projectArgs{}
+	if err := propertiesMapToStruct(olds, &previous); err != nil {
+		return nil, err
+	}
+	if err := propertiesMapToStruct(news, &args); err != nil {
+		return nil, err
+	}
+	body := client.UpdateProjectJSONRequestBody{}
+	if previous.Name != args.Name {
+		body.Name = &args.Name
+	}
+	// An imported project has no plan nor KPS setting in state, those of the program are recorded as they are
+	if previous.Plan != "" && previous.Plan != args.Plan {
+		body.Plan = &args.Plan
+	}
+	if args.KpsEnabled != nil && previous.KpsEnabled != nil && *previous.KpsEnabled != *args.KpsEnabled {
+		body.KpsEnabled = args.KpsEnabled
+	}
+	state := &projectState{}
+	if err := propertiesMapToStruct(olds, state); err != nil {
+		return nil, err
+	}
+	if !preview && state.Status != string(client.ProjectStatusACTIVEHEALTHY) {
+		// The project was not ready when it was created, it is waited for again before being updated
+		projectStatus, err := p.waitForProject(ctx, id, timeout)
+		state.Status = string(projectStatus)
+		if err != nil {
+			return state, err
 		}
-		if key == "region" {
-			changes = append(changes, string(key))
-			recreate = true
+	}
+	state.Name = args.Name
+	if !preview && (body.Name != nil || body.Plan != nil || body.KpsEnabled != nil) {
+		updated, err := p.supabase.UpdateProjectWithResponse(withRetrySafe(ctx), id, body)
+		if err := checkForSupabaseError(updated, err); err != nil {
+			return nil, err
+		}
+		if updated.JSON200 != nil {
+			state.Name = updated.JSON200.Name
+		}
+	}
+	if !preview && body.Plan != nil {
+		// The update response has no plan, it is read back so a change the API ignored is not recorded as done
+		_, current, err := p.readProject(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if current == nil {
+			return nil, fmt.Errorf("project %s not found after the update", id)
+		}
+		if current.Plan != string(args.Plan) {
+			return nil, fmt.Errorf("plan of project %s could not be confirmed as %s after the update (reported plan: %q)", id, args.Plan, current.Plan)
 		}
+		state.Plan = current.Plan
+	}
+	return state, nil
+}
+
+// projectProtected tells whether the state of a project has its deletion protection enabled, as it is by default
+func projectProtected(state resource.PropertyMap) bool {
+	protected, ok := state["deletionProtection"]
+	return !ok || !protected.IsBool() || protected.BoolValue()
+}
+
+func (p *supabaseProvider) deleteProject(ctx context.Context, id string, inputs resource.PropertyMap) error {
+	if projectProtected(inputs) {
+		return status.Errorf(codes.FailedPrecondition, "project %s has deletion protection enabled (set deletionProtection to false and update before deleting)", id)
 	}
-	return changes, recreate
+	project, err := p.supabase.DeleteProjectWithResponse(ctx, id)
+	return checkForSupabaseError(project, err)
 }
@@ -3,17 +3,23 @@ package provider
 import (
 	"context"
 	"fmt"
+	"math"
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/LuxChanLu/pulumi-supabase/pkg/provider/client"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/pulumi/pulumi/pkg/v3/resource/provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -27,22 +33,32 @@ import (
 
 const configServerKey = "server"
 const configTokenKey = "token"
+const configMaxRetriesKey = "maxRetries"
+const configRetryBaseDelayKey = "retryBaseDelay"
+const configRetryMaxDelayKey = "retryMaxDelay"
+const configMaxRequestsPerSecondKey = "maxRequestsPerSecond"
 
 type supabaseProvider struct {
 	host     *provider.HostClient
 	name     string
 	version  string
 	schema   []byte
+	schemas  providerSchema
 	supabase *client.ClientWithResponses
 }
 
 func makeProvider(host *provider.HostClient, name, version string, pulumiSchema []byte) (pulumirpc.ResourceProviderServer, error) {
+	schemas, err := parseSchema(pulumiSchema)
+	if err != nil {
+		return nil, fmt.Errorf("invalid provider schema: %w", err)
+	}
 	// Return the new provider
 	return &supabaseProvider{
 		host:    host,
 		name:    name,
 		version: version,
 		schema:  pulumiSchema,
+		schemas: schemas,
 	}, nil
 }
 
@@ -65,6 +81,13 @@ func (p *supabaseProvider) Call(ctx context.Context, req *pulumirpc.CallRequest)
 func (p *supabaseProvider) Configure(_ context.Context, req *pulumirpc.ConfigureRequest) (*pulumirpc.ConfigureResponse, error) {
 	server, _ := os.LookupEnv("SUPABASE_SERVER")
 	token, _ := os.LookupEnv("SUPABASE_TOKEN")
+	requestsPerSecond := defaultMaxRequestsPerSecond
+	transport := &retryTransport{
+		maxRetries: defaultMaxRetries,
+		baseDelay:  defaultRetryBaseDelay,
+		maxDelay:   defaultRetryMaxDelay,
+		log:        p.logRetry,
+	}
 	for key, value := range req.GetVariables() {
 		if key == "supabase:config:"+configServerKey {
 			server = value
@@ -72,11 +95,40 @@ func (p *supabaseProvider) Configure(_ context.Context, req *pulumirpc.Configure
 		if key == "supabase:config:"+configTokenKey {
 			token = value
 		}
+		if key == "supabase:config:"+configMaxRetriesKey {
+			maxRetries, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", configMaxRetriesKey, err)
+			}
+			transport.maxRetries = maxRetries
+		}
+		if key == "supabase:config:"+configRetryBaseDelayKey {
+			seconds, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", configRetryBaseDelayKey, err)
+			}
+			transport.baseDelay = time.Duration(seconds * float64(time.Second))
+		}
+		if key == "supabase:config:"+configRetryMaxDelayKey {
+			seconds, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", configRetryMaxDelayKey, err)
+			}
+			transport.maxDelay = time.Duration(seconds * float64(time.Second))
+		}
+		if key == "supabase:config:"+configMaxRequestsPerSecondKey {
+			perSecond, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", configMaxRequestsPerSecondKey, err)
+			}
+			requestsPerSecond = perSecond
+		}
 	}
+	transport.next = newRateLimitTransport(http.DefaultTransport, requestsPerSecond)
 	if server == "" {
 		server = "https://api.supabase.com/"
 	}
-	supabase, err := client.NewClientWithResponses(server, client.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+	supabase, err := client.NewClientWithResponses(server, client.WithHTTPClient(&http.Client{Transport: transport}), client.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
 		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
 		return nil
 	}))
@@ -92,6 +144,32 @@ func (p *supabaseProvider) Configure(_ context.Context, req *pulumirpc.Configure
 	}, nil
 }
 
+// logRetry reports a retried Management API call through the Pulumi host
+func (p *supabaseProvider) logRetry(ctx context.Context, message string) {
+	p.logStatus(ctx, "", message)
+}
+
+// logStatus shows an informational message to the user, attached to a resource when urn is set
+func (p *supabaseProvider) logStatus(ctx context.Context, urn resource.URN, message string) {
+	if p.host == nil {
+		logging.V(5).Info(message)
+		return
+	}
+	if err := p.host.Log(ctx, diag.Info, urn, message); err != nil {
+		logging.V(5).Infof("%s (failed to log through host: %s)", message, err)
+	}
+}
+
+// notReadyError reports a resource created or updated but not ready so its state is saved and the next update resumes it
+func (p *supabaseProvider) notReadyError(typ tokens.Type, id string, state interface{}, inputs resource.PropertyMap, reason error) error {
+	outputs, err := stateToOutputs(state, inputs)
+	if err != nil {
+		return err
+	}
+	resourceDiffs[typ].keepInputs(inputs, outputs)
+	return resourceInitError(id, p.schemas.wrapSecrets(typ, outputs), reason)
+}
+
 // CheckConfig validates the configuration for this provider.
 func (p *supabaseProvider) CheckConfig(ctx context.Context, req *pulumirpc.CheckRequest) (*pulumirpc.CheckResponse, error) {
 	hasToken := false
@@ -112,6 +190,16 @@ func (p *supabaseProvider) CheckConfig(ctx context.Context, req *pulumirpc.Check
 		if key == configTokenKey {
 			hasToken = true
 		}
+		if key == configMaxRetriesKey {
+			if number, ok := configNumber(value); !ok || number < 0 || number != math.Trunc(number) {
+				failures = append(failures, &pulumirpc.CheckFailure{Property: string(key), Reason: fmt.Sprintf("%s must be a non-negative integer", key)})
+			}
+		}
+		if key == configRetryBaseDelayKey || key == configRetryMaxDelayKey || key == configMaxRequestsPerSecondKey {
+			if number, ok := configNumber(value); !ok || number < 0 {
+				failures = append(failures, &pulumirpc.CheckFailure{Property: string(key), Reason: fmt.Sprintf("%s must be a non-negative number", key)})
+			}
+		}
 	}
 	if !hasToken {
 		failures = append(failures, &pulumirpc.CheckFailure{Property: configTokenKey, Reason: "missing supabase token"})
@@ -122,6 +210,20 @@ func (p *supabaseProvider) CheckConfig(ctx context.Context, req *pulumirpc.Check
 	return &pulumirpc.CheckResponse{Inputs: req.GetNews()}, nil
 }
 
+// configNumber reads a numeric config value which may be given as a string, unknown values are accepted
+func configNumber(value resource.PropertyValue) (float64, bool) {
+	switch {
+	case value.IsComputed():
+		return 0, true
+	case value.IsNumber():
+		return value.NumberValue(), true
+	case value.IsString():
+		number, err := strconv.ParseFloat(value.StringValue(), 64)
+		return number, err == nil
+	}
+	return 0, false
+}
+
 // DiffConfig diffs the configuration for this provider.
 func (p *supabaseProvider) DiffConfig(ctx context.Context, req *pulumirpc.DiffRequest) (*pulumirpc.DiffResponse, error) {
 	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true})
@@ -155,29 +257,34 @@ func (p *supabaseProvider) Invoke(ctx context.Context, req *pulumirpc.InvokeRequ
 		return nil, err
 	}
 
+	var result interface{}
 	switch tok {
 	case "supabase:index:GetTypeScript":
 		schema, err := p.supabase.GetTypescriptTypesWithResponse(ctx, inputs["projectId"].StringValue(), &client.GetTypescriptTypesParams{IncludedSchemas: pulumi.StringRef(inputs["includedSchemas"].StringValue())})
 		if err != nil {
 			return nil, err
 		}
-		if schema.JSON200 != nil {
-			outputs := map[string]interface{}{}
-
-			if err := structToOutputs(schema.JSON200, &outputs); err != nil {
-				return nil, err
-			}
-
-			outputProperties, err := plugin.MarshalProperties(resource.NewPropertyMapFromMap(outputs), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true})
-			if err != nil {
-				return nil, err
-			}
-
-			return &pulumirpc.InvokeResponse{Return: outputProperties}, nil
+		if schema.JSON200 == nil {
+			return &pulumirpc.InvokeResponse{Failures: []*pulumirpc.CheckFailure{{Property: "types", Reason: "Types not found"}}}, nil
+		}
+		result = schema.JSON200
+	case "supabase:index:getNetworkBans":
+		if result, err = p.getNetworkBans(ctx, inputs["projectId"].StringValue()); err != nil {
+			return nil, err
 		}
-		return &pulumirpc.InvokeResponse{Failures: []*pulumirpc.CheckFailure{{Property: "types", Reason: "Types not found"}}}, nil
+	default:
+		return nil, fmt.Errorf("unknown Invoke token '%s'", tok)
+	}
+
+	outputs, err := stateToOutputs(result, nil)
+	if err != nil {
+		return nil, err
+	}
+	outputProperties, err := plugin.MarshalProperties(outputs, plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true})
+	if err != nil {
+		return nil, err
 	}
-	return nil, fmt.Errorf("unknown Invoke token '%s'", tok)
+	return &pulumirpc.InvokeResponse{Return: outputProperties}, nil
 }
 
 // StreamInvoke dynamically executes a built-in function in the provider. The result is streamed
@@ -194,7 +301,47 @@ func (p *supabaseProvider) StreamInvoke(req *pulumirpc.InvokeRequest, server pul
 // required for correctness, violations thereof can negatively impact the end-user experience, as
 // the provider inputs are using for detecting and rendering diffs.
 func (p *supabaseProvider) Check(ctx context.Context, req *pulumirpc.CheckRequest) (*pulumirpc.CheckResponse, error) {
-	return &pulumirpc.CheckResponse{Inputs: req.News, Failures: nil}, nil
+	urn := resource.URN(req.GetUrn())
+	checkResource, ok := resourceChecks[urn.Type()]
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, fmt.Sprintf("%s does not exist", urn.Type()))
+	}
+
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepSecrets: true})
+	if err != nil {
+		return nil, err
+	}
+	p.schemas.applyDefaults(urn.Type(), news)
+
+	failures := append(p.schemas.check(urn.Type(), news), checkResource(news)...)
+	if len(failures) > 0 {
+		return &pulumirpc.CheckResponse{Inputs: req.News, Failures: failures}, nil
+	}
+	if urn.Type() == "supabase:index:Function" {
+		// The body and the source of a function are hashed here so Diff compares hashes, editing the files of the source is seen even if its path stays the same
+		if err := hashFunction(news); err != nil {
+			return &pulumirpc.CheckResponse{Inputs: req.News, Failures: []*pulumirpc.CheckFailure{checkFailure("source", "%s", err)}}, nil
+		}
+	}
+	if urn.Type() == "supabase:index:VanitySubdomain" {
+		olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepSecrets: true})
+		if err != nil {
+			return nil, err
+		}
+		// The availability is checked here so a taken subdomain fails the preview instead of a partial update
+		failure, err := p.checkVanitySubdomainAvailability(ctx, olds, news)
+		if err != nil {
+			return nil, err
+		}
+		if failure != nil {
+			return &pulumirpc.CheckResponse{Inputs: req.News, Failures: []*pulumirpc.CheckFailure{failure}}, nil
+		}
+	}
+	inputs, err := plugin.MarshalProperties(news, plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepSecrets: true})
+	if err != nil {
+		return nil, err
+	}
+	return &pulumirpc.CheckResponse{Inputs: inputs, Failures: nil}, nil
 }
 
 // Diff checks what impacts a hypothetical update will have on the resource's properties.
@@ -211,30 +358,28 @@ func (p *supabaseProvider) Diff(ctx context.Context, req *pulumirpc.DiffRequest)
 		return nil, err
 	}
 
-	replaces := []string{}
-	deleteBeforeReplace := false
-
-	diff := olds.Diff(news)
-	changes := pulumirpc.DiffResponse_DIFF_NONE
-
-	switch urn.Type() {
-	case "supabase:index:Organization":
-		replaces, deleteBeforeReplace = p.diffOrganization(ctx, diff)
-	case "supabase:index:Project":
-		replaces, deleteBeforeReplace = p.diffProject(ctx, diff)
-	case "supabase:index:Function":
-		replaces, deleteBeforeReplace = p.diffFunction(ctx, diff)
-	case "supabase:index:Secret":
-		replaces, deleteBeforeReplace = p.diffSecret(ctx, diff)
-	default:
+	diff, ok := resourceDiffs[urn.Type()]
+	if !ok {
 		return nil, status.Error(codes.Unimplemented, fmt.Sprintf("%s does not exist", urn.Type()))
 	}
-
-	return &pulumirpc.DiffResponse{
-		Changes:             changes,
-		Replaces:            replaces,
-		DeleteBeforeReplace: deleteBeforeReplace,
-	}, nil
+	if urn.Type() == "supabase:index:Function" && !olds.HasValue("bodyHash") {
+		// Functions created before the body was hashed only have their body in state
+		if hash := functionBodyHash(olds); hash != "" {
+			olds["bodyHash"] = resource.NewStringProperty(hash)
+		}
+	}
+	if urn.Type() == "supabase:index:Secret" && !olds.HasValue("value") {
+		// Imported secrets only have the digest of their value, a value matching it is not a change
+		if value, ok := stringInput(news, "value"); ok && olds["digest"].IsString() && olds["digest"].StringValue() == secretDigest(value) {
+			olds["value"] = news["value"]
+		}
+	}
+	response := diff.diff(olds, news, req.GetIgnoreChanges())
+	if urn.Type() == "supabase:index:Project" && len(response.Replaces) > 0 && projectProtected(olds) {
+		// The replacement would be created before failing to delete the protected project, leaving both
+		return nil, status.Errorf(codes.FailedPrecondition, "project %s has deletion protection enabled and cannot be replaced (changed: %s), set deletionProtection to false and update before replacing it", req.GetId(), strings.Join(response.Replaces, ", "))
+	}
+	return response, nil
 }
 
 // Construct creates a new component resource.
@@ -248,39 +393,91 @@ func (p *supabaseProvider) Create(ctx context.Context, req *pulumirpc.CreateRequ
 	urn := resource.URN(req.GetUrn())
 	id := ""
 
-	inputs, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true})
+	inputs, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepSecrets: true})
 	if err != nil {
 		return nil, err
 	}
 
-	outputs := map[string]interface{}{}
+	var state interface{}
 
 	switch urn.Type() {
 	case "supabase:index:Organization":
-		id, err = p.createOrganization(ctx, inputs, req.GetPreview(), &outputs)
+		id, state, err = p.createOrganization(ctx, inputs, req.GetPreview())
 		if err != nil {
 			return nil, err
 		}
 	case "supabase:index:Project":
-		id, err = p.createProject(ctx, inputs, req.GetPreview(), &outputs)
+		id, state, err = p.createProject(ctx, inputs, time.Duration(req.GetTimeout()*float64(time.Second)), req.GetPreview())
+		if err != nil && id != "" {
+			return nil, p.notReadyError(urn.Type(), id, state, inputs, err)
+		}
 		if err != nil {
 			return nil, err
 		}
 	case "supabase:index:Function":
-		id, err = p.createFunction(ctx, inputs, inputs["projectId"].StringValue(), req.GetPreview(), &outputs)
+		id, state, err = p.createFunction(ctx, inputs, req.GetPreview())
 		if err != nil {
 			return nil, err
 		}
 	case "supabase:index:Secret":
-		id, err = p.createSecret(ctx, inputs, inputs["projectId"].StringValue(), req.GetPreview(), &outputs)
+		id, state, err = p.createSecret(ctx, inputs, req.GetPreview())
+		if err != nil {
+			return nil, err
+		}
+	case "supabase:index:SecretSet":
+		id, state, err = p.createSecretSet(ctx, inputs, req.GetPreview())
+		if err != nil {
+			return nil, err
+		}
+	case "supabase:index:ProjectAuthConfig":
+		id, state, err = p.createAuthConfig(ctx, inputs, req.GetPreview())
+		if err != nil {
+			return nil, err
+		}
+	case "supabase:index:PostgrestConfig":
+		id, state, err = p.createPostgrestConfig(ctx, inputs, req.GetPreview())
+		if err != nil {
+			return nil, err
+		}
+	case "supabase:index:NetworkRestrictions":
+		id, state, err = p.createNetworkRestrictions(ctx, inputs, req.GetPreview())
+		if err != nil {
+			return nil, err
+		}
+	case "supabase:index:NetworkBanRemoval":
+		id, state, err = p.createNetworkBanRemoval(ctx, inputs, req.GetPreview())
+		if err != nil {
+			return nil, err
+		}
+	case "supabase:index:SslEnforcement":
+		id, state, err = p.createSslEnforcement(ctx, inputs, req.GetPreview())
+		if err != nil {
+			return nil, err
+		}
+	case "supabase:index:VanitySubdomain":
+		id, state, err = p.createVanitySubdomain(ctx, inputs, req.GetPreview())
+		if err != nil {
+			return nil, err
+		}
+	case "supabase:index:CustomHostname":
+		id, state, err = p.createCustomHostname(ctx, urn, inputs, time.Duration(req.GetTimeout()*float64(time.Second)), req.GetPreview())
+		if err != nil && id != "" {
+			return nil, p.notReadyError(urn.Type(), id, state, inputs, err)
+		}
 		if err != nil {
 			return nil, err
 		}
 	default:
 		return nil, status.Error(codes.Unimplemented, fmt.Sprintf("%s does not exist", urn.Type()))
 	}
+	outputs, err := stateToOutputs(state, inputs)
+	if err != nil {
+		return nil, err
+	}
+	resourceDiffs[urn.Type()].keepInputs(inputs, outputs)
+	p.schemas.wrapSecrets(urn.Type(), outputs)
 
-	outputProperties, err := plugin.MarshalProperties(resource.NewPropertyMapFromMap(outputs), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true})
+	outputProperties, err := plugin.MarshalProperties(outputs, plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepSecrets: true})
 	if err != nil {
 		return nil, err
 	}
@@ -293,77 +490,189 @@ func (p *supabaseProvider) Read(ctx context.Context, req *pulumirpc.ReadRequest)
 	urn := resource.URN(req.GetUrn())
 	id := ""
 
-	inputs, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepOutputValues: false})
+	inputs, err := plugin.UnmarshalProperties(req.GetProperties(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepOutputValues: false, KeepSecrets: true})
 	if err != nil {
 		return nil, err
 	}
+	if !inputs.HasValue("projectId") {
+		// Functions and secrets created before their project was stored in state only have it in their inputs
+		resourceInputs, err := plugin.UnmarshalProperties(req.GetInputs(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepSecrets: true})
+		if err != nil {
+			return nil, err
+		}
+		if resourceInputs.HasValue("projectId") {
+			inputs["projectId"] = resourceInputs["projectId"]
+		}
+	}
 
-	outputs := map[string]interface{}{}
+	var state interface{}
 
 	switch urn.Type() {
 	case "supabase:index:Organization":
-		id, err = p.readOrganization(ctx, req.Id, &outputs)
+		id, state, err = p.readOrganization(ctx, req.Id)
 		if err != nil {
 			return nil, err
 		}
 	case "supabase:index:Project":
-		id, err = p.readProject(ctx, req.Id, &outputs)
+		id, state, err = p.readProject(ctx, req.Id)
 		if err != nil {
 			return nil, err
 		}
 	case "supabase:index:Function":
-		id, err = p.readFunction(ctx, inputs["projectId"].StringValue(), inputs["slug"].StringValue(), &outputs)
+		projectId, slug, err := parseProjectResourceId(req.GetId(), inputs, "slug")
+		if err != nil {
+			return nil, err
+		}
+		id, state, err = p.readFunction(ctx, projectId, slug, inputs)
 		if err != nil {
 			return nil, err
 		}
 	case "supabase:index:Secret":
-		id, err = p.readSecret(ctx, inputs["projectId"].StringValue(), inputs["name"].StringValue(), &outputs)
+		projectId, name, err := parseProjectResourceId(req.GetId(), inputs, "name")
+		if err != nil {
+			return nil, err
+		}
+		id, state, err = p.readSecret(ctx, projectId, name, inputs)
+		if err != nil {
+			return nil, err
+		}
+	case "supabase:index:SecretSet":
+		id, state, err = p.readSecretSet(ctx, req.GetId(), inputs)
+		if err != nil {
+			return nil, err
+		}
+	case "supabase:index:ProjectAuthConfig":
+		id, state, err = p.readAuthConfig(ctx, req.GetId(), inputs)
+		if err != nil {
+			return nil, err
+		}
+	case "supabase:index:PostgrestConfig":
+		id, state, err = p.readPostgrestConfig(ctx, req.GetId(), inputs)
+		if err != nil {
+			return nil, err
+		}
+	case "supabase:index:NetworkRestrictions":
+		id, state, err = p.readNetworkRestrictions(ctx, req.GetId(), inputs)
+		if err != nil {
+			return nil, err
+		}
+	case "supabase:index:NetworkBanRemoval":
+		id, state, err = p.readNetworkBanRemoval(req.GetId(), inputs)
+		if err != nil {
+			return nil, err
+		}
+	case "supabase:index:SslEnforcement":
+		id, state, err = p.readSslEnforcement(ctx, req.GetId())
+		if err != nil {
+			return nil, err
+		}
+	case "supabase:index:CustomHostname":
+		id, state, err = p.readCustomHostname(ctx, req.GetId())
+		if err != nil {
+			return nil, err
+		}
+	case "supabase:index:VanitySubdomain":
+		id, state, err = p.readVanitySubdomain(ctx, req.GetId())
 		if err != nil {
 			return nil, err
 		}
 	default:
 		return nil, status.Error(codes.Unimplemented, fmt.Sprintf("%s does not exist", urn.Type()))
 	}
-	outputProperties, err := plugin.MarshalProperties(resource.NewPropertyMapFromMap(outputs), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true})
+	outputs := resource.PropertyMap{}
+	if id != "" {
+		if outputs, err = stateToOutputs(state, inputs); err != nil {
+			return nil, err
+		}
+		resourceDiffs[urn.Type()].keepInputs(inputs, outputs)
+		p.schemas.wrapSecrets(urn.Type(), outputs)
+	}
+	outputProperties, err := plugin.MarshalProperties(outputs, plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepSecrets: true})
+	if err != nil {
+		return nil, err
+	}
+	inputProperties, err := plugin.MarshalProperties(resourceDiffs[urn.Type()].inputsOf(outputs), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepSecrets: true})
 	if err != nil {
 		return nil, err
 	}
-	return &pulumirpc.ReadResponse{Id: id, Properties: outputProperties}, nil
+	return &pulumirpc.ReadResponse{Id: id, Properties: outputProperties, Inputs: inputProperties}, nil
 }
 
 // Update updates an existing resource with new values.
 func (p *supabaseProvider) Update(ctx context.Context, req *pulumirpc.UpdateRequest) (*pulumirpc.UpdateResponse, error) {
 	urn := resource.URN(req.GetUrn())
 
-	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true})
+	olds, err := plugin.UnmarshalProperties(req.GetOlds(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepSecrets: true})
 	if err != nil {
 		return nil, err
 	}
 
-	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true})
+	news, err := plugin.UnmarshalProperties(req.GetNews(), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepSecrets: true})
 	if err != nil {
 		return nil, err
 	}
 
-	outputs := map[string]interface{}{}
+	var state interface{}
 
-	logging.Errorf("%+v %+v", olds, news)
 	switch urn.Type() {
 	case "supabase:index:Organization":
 		return nil, status.Error(codes.Unimplemented, "no update available for organization (update manually and refresh)")
 	case "supabase:index:Project":
-		return nil, status.Error(codes.Unimplemented, "no update available for organization project (update manually and refresh)")
+		project, err := p.updateProject(ctx, req.GetId(), olds, news, time.Duration(req.GetTimeout()*float64(time.Second)), req.GetPreview())
+		if err != nil && project != nil {
+			return nil, p.notReadyError(urn.Type(), req.GetId(), project, news, err)
+		}
+		if err != nil {
+			return nil, err
+		}
+		state = project
 	case "supabase:index:Function":
-		if err := p.updateFunction(ctx, news, olds["projectId"].StringValue(), olds["slug"].StringValue(), req.GetPreview(), &outputs); err != nil {
+		if state, err = p.updateFunction(ctx, news, req.GetPreview()); err != nil {
 			return nil, err
 		}
 	case "supabase:index:Secret":
-		return nil, status.Error(codes.Unimplemented, "no update available for project secret (update manually and refresh)")
+		if state, err = p.updateSecret(ctx, news, req.GetPreview()); err != nil {
+			return nil, err
+		}
+	case "supabase:index:SecretSet":
+		if state, err = p.updateSecretSet(ctx, olds, news, req.GetPreview()); err != nil {
+			return nil, err
+		}
+	case "supabase:index:ProjectAuthConfig":
+		if state, err = p.updateAuthConfig(ctx, news, req.GetPreview()); err != nil {
+			return nil, err
+		}
+	case "supabase:index:PostgrestConfig":
+		if state, err = p.updatePostgrestConfig(ctx, news, req.GetPreview()); err != nil {
+			return nil, err
+		}
+	case "supabase:index:NetworkRestrictions":
+		if state, err = p.updateNetworkRestrictions(ctx, news, req.GetPreview()); err != nil {
+			return nil, err
+		}
+	case "supabase:index:NetworkBanRemoval":
+		if state, err = p.updateNetworkBanRemoval(ctx, news, req.GetPreview()); err != nil {
+			return nil, err
+		}
+	case "supabase:index:SslEnforcement":
+		if state, err = p.updateSslEnforcement(ctx, news, req.GetPreview()); err != nil {
+			return nil, err
+		}
+	case "supabase:index:CustomHostname":
+		if state, err = p.updateCustomHostname(ctx, urn, req.GetId(), news, time.Duration(req.GetTimeout()*float64(time.Second)), req.GetPreview()); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, status.Error(codes.Unimplemented, fmt.Sprintf("%s does not exist", urn.Type()))
 	}
+	outputs, err := stateToOutputs(state, news)
+	if err != nil {
+		return nil, err
+	}
+	resourceDiffs[urn.Type()].keepInputs(news, outputs)
+	p.schemas.wrapSecrets(urn.Type(), outputs)
 
-	outputProperties, err := plugin.MarshalProperties(resource.NewPropertyMapFromMap(outputs), plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true})
+	outputProperties, err := plugin.MarshalProperties(outputs, plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepSecrets: true})
 	if err != nil {
 		return nil, err
 	}
@@ -384,11 +693,38 @@ func (p *supabaseProvider) Delete(ctx context.Context, req *pulumirpc.DeleteRequ
 	case "supabase:index:Organization":
 		return nil, status.Error(codes.Unimplemented, "no delete available for organization (delete manually and refresh)")
 	case "supabase:index:Project":
-		return nil, status.Error(codes.Unimplemented, "no delete available for organization project (delete manually and refresh)")
+		return &pbempty.Empty{}, p.deleteProject(ctx, req.GetId(), inputs)
 	case "supabase:index:Function":
-		return &pbempty.Empty{}, p.deleteFunction(ctx, inputs["projectId"].StringValue(), inputs["slug"].StringValue())
+		// Functions created before their project was stored in state get it back when refreshed or updated
+		projectId, slug, err := parseProjectResourceId(req.GetId(), inputs, "slug")
+		if err != nil {
+			return nil, fmt.Errorf("%w, refresh the function to record its project", err)
+		}
+		return &pbempty.Empty{}, p.deleteFunction(ctx, projectId, slug)
 	case "supabase:index:Secret":
-		return &pbempty.Empty{}, p.deleteSecret(ctx, inputs["projectId"].StringValue(), inputs["name"].StringValue())
+		projectId, name, err := parseProjectResourceId(req.GetId(), inputs, "name")
+		if err != nil {
+			return nil, fmt.Errorf("%w, refresh the secret to record its project", err)
+		}
+		return &pbempty.Empty{}, p.deleteSecret(ctx, projectId, name)
+	case "supabase:index:SecretSet":
+		return &pbempty.Empty{}, p.deleteSecretSet(ctx, inputs)
+	case "supabase:index:ProjectAuthConfig":
+		// The auth config lives as long as its project, it is only removed from the state
+		return &pbempty.Empty{}, nil
+	case "supabase:index:PostgrestConfig":
+		return &pbempty.Empty{}, p.deletePostgrestConfig(ctx, req.GetId())
+	case "supabase:index:NetworkRestrictions":
+		return &pbempty.Empty{}, p.deleteNetworkRestrictions(ctx, req.GetId())
+	case "supabase:index:NetworkBanRemoval":
+		// Lifted bans cannot be restored, the removal is only removed from the state
+		return &pbempty.Empty{}, nil
+	case "supabase:index:SslEnforcement":
+		return &pbempty.Empty{}, p.deleteSslEnforcement(ctx, req.GetId())
+	case "supabase:index:CustomHostname":
+		return &pbempty.Empty{}, p.deleteCustomHostname(ctx, req.GetId())
+	case "supabase:index:VanitySubdomain":
+		return &pbempty.Empty{}, p.deleteVanitySubdomain(ctx, req.GetId())
 	default:
 		return nil, status.Error(codes.Unimplemented, fmt.Sprintf("%s does not exist", urn.Type()))
 	}
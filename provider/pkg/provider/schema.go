@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+// resourceSchema is what the provider enforces at runtime from the schema of a resource type
+type resourceSchema struct {
+	// secrets are the properties marked `secret: true`, or holding such properties in the types they reference
+	secrets map[resource.PropertyKey]*secretSpec
+	// defaults are the values of the inputs declaring a default
+	defaults map[resource.PropertyKey]interface{}
+	// required are the required inputs
+	required []resource.PropertyKey
+	// enums are the allowed values of the inputs referencing an enum type
+	enums map[resource.PropertyKey][]string
+}
+
+type providerSchema map[tokens.Type]resourceSchema
+
+// secretSpec tells which part of a property value is secret: the whole value, or some of the values it holds
+type secretSpec struct {
+	secret bool
+	// properties are the secret properties of an object type
+	properties map[resource.PropertyKey]*secretSpec
+	// items are the secret parts of every item of an array, or of every value of a map
+	items *secretSpec
+}
+
+type schemaPropertySpec struct {
+	Secret               bool                `json:"secret"`
+	Default              interface{}         `json:"default"`
+	Ref                  string              `json:"$ref"`
+	Items                *schemaPropertySpec `json:"items"`
+	AdditionalProperties *schemaPropertySpec `json:"additionalProperties"`
+}
+
+type schemaTypeSpec struct {
+	Enum []struct {
+		Value interface{} `json:"value"`
+	} `json:"enum"`
+	Properties map[string]schemaPropertySpec `json:"properties"`
+}
+
+type schemaSpec struct {
+	Types     map[string]schemaTypeSpec `json:"types"`
+	Resources map[string]struct {
+		InputProperties map[string]schemaPropertySpec `json:"inputProperties"`
+		RequiredInputs  []string                      `json:"requiredInputs"`
+		Properties      map[string]schemaPropertySpec `json:"properties"`
+	} `json:"resources"`
+}
+
+func parseSchema(pulumiSchema []byte) (providerSchema, error) {
+	spec := schemaSpec{}
+	if err := json.Unmarshal(pulumiSchema, &spec); err != nil {
+		return nil, err
+	}
+	schemas := providerSchema{}
+	for token, resourceSpec := range spec.Resources {
+		schema := resourceSchema{
+			secrets:  map[resource.PropertyKey]*secretSpec{},
+			defaults: map[resource.PropertyKey]interface{}{},
+			enums:    map[resource.PropertyKey][]string{},
+		}
+		for name, property := range resourceSpec.InputProperties {
+			if secret := spec.secretsOf(property, map[string]bool{}); secret != nil {
+				schema.secrets[resource.PropertyKey(name)] = secret
+			}
+			if property.Default != nil {
+				schema.defaults[resource.PropertyKey(name)] = property.Default
+			}
+			if enum, ok := spec.Types[strings.TrimPrefix(property.Ref, "#/types/")]; ok && len(enum.Enum) > 0 {
+				for _, value := range enum.Enum {
+					schema.enums[resource.PropertyKey(name)] = append(schema.enums[resource.PropertyKey(name)], fmt.Sprint(value.Value))
+				}
+			}
+		}
+		for name, property := range resourceSpec.Properties {
+			if secret := spec.secretsOf(property, map[string]bool{}); secret != nil {
+				schema.secrets[resource.PropertyKey(name)] = secret
+			}
+		}
+		for _, name := range resourceSpec.RequiredInputs {
+			schema.required = append(schema.required, resource.PropertyKey(name))
+		}
+		schemas[tokens.Type(token)] = schema
+	}
+	return schemas, nil
+}
+
+// secretsOf returns the secret parts of a property, following the types it references, or nil when none is secret
+func (spec schemaSpec) secretsOf(property schemaPropertySpec, visiting map[string]bool) *secretSpec {
+	if property.Secret {
+		return &secretSpec{secret: true}
+	}
+	if property.Items != nil {
+		if items := spec.secretsOf(*property.Items, visiting); items != nil {
+			return &secretSpec{items: items}
+		}
+	}
+	if property.AdditionalProperties != nil {
+		if items := spec.secretsOf(*property.AdditionalProperties, visiting); items != nil {
+			return &secretSpec{items: items}
+		}
+	}
+	typeName := strings.TrimPrefix(property.Ref, "#/types/")
+	typeSpec, ok := spec.Types[typeName]
+	if !ok || visiting[typeName] {
+		return nil
+	}
+	visiting[typeName] = true
+	defer delete(visiting, typeName)
+	properties := map[resource.PropertyKey]*secretSpec{}
+	for name, nested := range typeSpec.Properties {
+		if secret := spec.secretsOf(nested, visiting); secret != nil {
+			properties[resource.PropertyKey(name)] = secret
+		}
+	}
+	if len(properties) == 0 {
+		return nil
+	}
+	return &secretSpec{properties: properties}
+}
+
+// wrapSecrets marks as secret the properties of a resource declared secret in the schema, whatever the API returned them as
+func (s providerSchema) wrapSecrets(resourceType tokens.Type, properties resource.PropertyMap) resource.PropertyMap {
+	for key, secret := range s[resourceType].secrets {
+		if value, ok := properties[key]; ok {
+			properties[key] = secret.wrap(value)
+		}
+	}
+	return properties
+}
+
+func (s *secretSpec) wrap(value resource.PropertyValue) resource.PropertyValue {
+	if value.IsNull() || value.IsSecret() || value.IsComputed() {
+		return value
+	}
+	if s.secret {
+		return resource.MakeSecret(value)
+	}
+	switch {
+	case value.IsObject() && (s.properties != nil || s.items != nil):
+		object := resource.PropertyMap{}
+		for key, nested := range value.ObjectValue() {
+			if secret := s.properties[key]; secret != nil {
+				nested = secret.wrap(nested)
+			} else if s.items != nil {
+				nested = s.items.wrap(nested)
+			}
+			object[key] = nested
+		}
+		return resource.NewObjectProperty(object)
+	case value.IsArray() && s.items != nil:
+		array := make([]resource.PropertyValue, len(value.ArrayValue()))
+		for i, item := range value.ArrayValue() {
+			array[i] = s.items.wrap(item)
+		}
+		return resource.NewArrayProperty(array)
+	}
+	return value
+}
+
+// applyDefaults sets the schema default of the inputs left unset, so Diff and the state see the value actually used
+func (s providerSchema) applyDefaults(resourceType tokens.Type, inputs resource.PropertyMap) {
+	for key, value := range s[resourceType].defaults {
+		if !inputs.HasValue(key) {
+			inputs[key] = resource.NewPropertyValue(value)
+		}
+	}
+}
+
+// check reports the missing required inputs and the values outside of their enum
+func (s providerSchema) check(resourceType tokens.Type, inputs resource.PropertyMap) []*pulumirpc.CheckFailure {
+	schema := s[resourceType]
+	failures := []*pulumirpc.CheckFailure{}
+	for _, key := range schema.required {
+		if !inputs.HasValue(key) {
+			failures = append(failures, checkFailure(key, "missing required property '%s'", key))
+		}
+	}
+	keys := make([]string, 0, len(schema.enums))
+	for key := range schema.enums {
+		keys = append(keys, string(key))
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value, ok := stringInput(inputs, resource.PropertyKey(key))
+		if ok && !containsString(schema.enums[resource.PropertyKey(key)], value) {
+			failures = append(failures, checkFailure(resource.PropertyKey(key), "'%s' is not a valid %s, expected one of: %s", value, key, strings.Join(schema.enums[resource.PropertyKey(key)], ", ")))
+		}
+	}
+	return failures
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -2,60 +2,115 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 
 	"github.com/LuxChanLu/pulumi-supabase/pkg/provider/client"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
 )
 
-func (p *supabaseProvider) createSecret(ctx context.Context, inputs resource.PropertyMap, projectId string, preview bool, outputs *map[string]interface{}) (string, error) {
-	body := client.CreateSecretBody{}
-	if err := propertiesMapToStruct(inputs, &body); err != nil {
-		return "", err
-	}
-	if !preview {
-		secret, err := p.supabase.CreateSecretsWithResponse(ctx, projectId, client.CreateSecretsJSONRequestBody{body})
-		if err := checkForSupabaseError(secret.HTTPResponse, err); err != nil {
-			return "", err
-		}
-		if err := structToOutputs(client.SecretResponse{Name: body.Name}, outputs); err != nil {
-			return "", err
-		}
-		return body.Name, nil
+// secretArgs are the inputs of a secret
+type secretArgs struct {
+	ProjectId string `json:"projectId"`
+	Name      string `json:"name"`
+	Value     string `json:"value"`
+}
+
+// secretState are the outputs of a secret, its value is only reported while it matches the deployed digest
+type secretState struct {
+	ProjectId string  `json:"projectId"`
+	Name      string  `json:"name"`
+	Value     *string `json:"value,omitempty"`
+	Digest    string  `json:"digest,omitempty"`
+}
+
+func checkSecret(inputs resource.PropertyMap) []*pulumirpc.CheckFailure {
+	if name, ok := stringInput(inputs, "name"); ok {
+		return checkSecretName("name", name)
 	}
-	if err := structToOutputs(client.SecretResponse{Name: body.Name}, outputs); err != nil {
-		return "", err
+	return nil
+}
+
+func (p *supabaseProvider) createSecret(ctx context.Context, inputs resource.PropertyMap, preview bool) (string, *secretState, error) {
+	state, err := p.upsertSecret(ctx, inputs, preview)
+	if err != nil || preview {
+		return "", state, err
 	}
-	return "", nil
+	return projectResourceId(state.ProjectId, state.Name), state, nil
 }
 
-func (p *supabaseProvider) readSecret(ctx context.Context, projectId, name string, outputs *map[string]interface{}) (string, error) {
+func (p *supabaseProvider) readSecret(ctx context.Context, projectId, name string, known resource.PropertyMap) (string, *secretState, error) {
 	secrets, err := p.supabase.GetSecretsWithResponse(ctx, projectId)
-	if err != nil || secrets.JSON200 == nil {
-		return "", err
+	if err := checkForSupabaseError(secrets, err); err != nil {
+		if isNotFound(err) {
+			return "", nil, nil
+		}
+		return "", nil, err
+	}
+	if secrets.JSON200 == nil {
+		return "", nil, errUnexpectedResponse(secrets)
 	}
 	for _, secret := range *secrets.JSON200 {
 		if secret.Name == name {
-			if err := structToOutputs(secret, outputs); err != nil {
-				return "", err
+			state := &secretState{ProjectId: projectId, Name: secret.Name}
+			// The API only exposes a digest, the known value is kept while it still matches what is deployed
+			args := secretArgs{}
+			if err := propertiesMapToStruct(known, &args); err != nil {
+				return "", nil, err
+			}
+			if known.HasValue("value") && (secret.Value == secretDigest(args.Value) || secret.Value == args.Value) {
+				state.Value = &args.Value
+				state.Digest = secretDigest(args.Value)
+			} else if isSecretDigest(secret.Value) {
+				state.Digest = secret.Value
+			} else {
+				state.Digest = secretDigest(secret.Value)
 			}
-			return secret.Name, nil
+			return projectResourceId(projectId, secret.Name), state, nil
 		}
 	}
-	return "", nil
+	return "", nil, nil
 }
 
-func (p *supabaseProvider) deleteSecret(ctx context.Context, projectId, name string) error {
-	function, err := p.supabase.DeleteSecretsWithResponse(ctx, projectId, client.DeleteSecretsJSONRequestBody{name})
-	return checkForSupabaseError(function.HTTPResponse, err)
+func (p *supabaseProvider) updateSecret(ctx context.Context, inputs resource.PropertyMap, preview bool) (*secretState, error) {
+	return p.upsertSecret(ctx, inputs, preview)
 }
 
-func (p *supabaseProvider) diffSecret(ctx context.Context, diff *resource.ObjectDiff) ([]string, bool) {
-	changes := []string{}
-	recreate := false
-	for _, key := range diff.ChangedKeys() {
-		if key == "name" || key == "value" {
-			changes = append(changes, string(key))
+// upsertSecret creates or overwrites the secret, the API treats both the same way
+func (p *supabaseProvider) upsertSecret(ctx context.Context, inputs resource.PropertyMap, preview bool) (*secretState, error) {
+	args := secretArgs{}
+	if err := propertiesMapToStruct(inputs, &args); err != nil {
+		return nil, err
+	}
+	if !preview {
+		secret, err := p.supabase.CreateSecretsWithResponse(withRetrySafe(ctx), args.ProjectId, client.CreateSecretsJSONRequestBody{{Name: args.Name, Value: args.Value}})
+		if err := checkForSupabaseError(secret, err); err != nil {
+			return nil, err
 		}
 	}
-	return changes, recreate
+	state := &secretState{ProjectId: args.ProjectId, Name: args.Name}
+	if inputs["value"].IsString() || inputs["value"].IsSecret() {
+		state.Digest = secretDigest(args.Value)
+	}
+	return state, nil
+}
+
+// secretDigest is the SHA-256 hex digest the API reports in place of secret values
+func secretDigest(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+func isSecretDigest(value string) bool {
+	if len(value) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(value)
+	return err == nil
+}
+
+func (p *supabaseProvider) deleteSecret(ctx context.Context, projectId, name string) error {
+	function, err := p.supabase.DeleteSecretsWithResponse(ctx, projectId, client.DeleteSecretsJSONRequestBody{name})
+	return checkForSupabaseError(function, err)
 }
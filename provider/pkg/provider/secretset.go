@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/LuxChanLu/pulumi-supabase/pkg/provider/client"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+// reservedSecretPrefix marks the secrets managed by Supabase itself, they are never pruned
+const reservedSecretPrefix = "SUPABASE_"
+
+// secretSet are the inputs of a secret set
+type secretSet struct {
+	ProjectId string            `json:"projectId"`
+	Secrets   map[string]string `json:"secrets"`
+	Prune     bool              `json:"prune"`
+}
+
+// secretSetState are the outputs of a secret set, only the secrets matching their deployed digest are reported
+type secretSetState struct {
+	secretSet
+	Digests map[string]string `json:"digests"`
+}
+
+func checkSecretSet(inputs resource.PropertyMap) []*pulumirpc.CheckFailure {
+	secrets, ok := inputs["secrets"]
+	if ok && secrets.IsSecret() {
+		secrets = secrets.SecretValue().Element
+	}
+	if !ok || !secrets.IsObject() {
+		return nil
+	}
+	failures := []*pulumirpc.CheckFailure{}
+	for _, name := range secrets.ObjectValue().StableKeys() {
+		failures = append(failures, checkSecretName(resource.PropertyPath{"secrets", string(name)}, string(name))...)
+	}
+	return failures
+}
+
+func (p *supabaseProvider) createSecretSet(ctx context.Context, inputs resource.PropertyMap, preview bool) (string, *secretSetState, error) {
+	set := secretSet{}
+	if err := propertiesMapToStruct(inputs, &set); err != nil {
+		return "", nil, err
+	}
+	state := &secretSetState{secretSet: set, Digests: secretDigests(set.Secrets)}
+	if preview {
+		return "", state, nil
+	}
+	if err := p.upsertSecrets(ctx, set.ProjectId, set.Secrets, nil); err != nil {
+		return "", nil, err
+	}
+	if err := p.pruneSecrets(ctx, set); err != nil {
+		return "", nil, err
+	}
+	return set.ProjectId, state, nil
+}
+
+func (p *supabaseProvider) readSecretSet(ctx context.Context, projectId string, state resource.PropertyMap) (string, *secretSetState, error) {
+	known := secretSet{}
+	if err := propertiesMapToStruct(state, &known); err != nil {
+		return "", nil, err
+	}
+	secrets, err := p.supabase.GetSecretsWithResponse(ctx, projectId)
+	if err := checkForSupabaseError(secrets, err); err != nil {
+		if isNotFound(err) {
+			return "", nil, nil
+		}
+		return "", nil, err
+	}
+	if secrets.JSON200 == nil {
+		return "", nil, errUnexpectedResponse(secrets)
+	}
+	deployed := map[string]string{}
+	for _, secret := range *secrets.JSON200 {
+		deployed[secret.Name] = secret.Value
+	}
+
+	// Only the secrets still matching their known value are reported, the others are rewritten on the next update
+	current := &secretSetState{secretSet: secretSet{ProjectId: projectId, Secrets: map[string]string{}, Prune: known.Prune}, Digests: map[string]string{}}
+	for name, value := range known.Secrets {
+		if deployedValue, ok := deployed[name]; ok && (deployedValue == secretDigest(value) || deployedValue == value) {
+			current.Secrets[name] = value
+			current.Digests[name] = secretDigest(value)
+		}
+	}
+	return projectId, current, nil
+}
+
+func (p *supabaseProvider) updateSecretSet(ctx context.Context, olds, news resource.PropertyMap, preview bool) (*secretSetState, error) {
+	previous, set := secretSet{}, secretSet{}
+	if err := propertiesMapToStruct(olds, &previous); err != nil {
+		return nil, err
+	}
+	if err := propertiesMapToStruct(news, &set); err != nil {
+		return nil, err
+	}
+	if !preview {
+		if err := p.upsertSecrets(ctx, set.ProjectId, set.Secrets, previous.Secrets); err != nil {
+			return nil, err
+		}
+		removed := []string{}
+		for name := range previous.Secrets {
+			if _, ok := set.Secrets[name]; !ok {
+				removed = append(removed, name)
+			}
+		}
+		if err := p.deleteSecrets(ctx, set.ProjectId, removed); err != nil {
+			return nil, err
+		}
+		if err := p.pruneSecrets(ctx, set); err != nil {
+			return nil, err
+		}
+	}
+	return &secretSetState{secretSet: set, Digests: secretDigests(set.Secrets)}, nil
+}
+
+func (p *supabaseProvider) deleteSecretSet(ctx context.Context, state resource.PropertyMap) error {
+	set := secretSet{}
+	if err := propertiesMapToStruct(state, &set); err != nil {
+		return err
+	}
+	names := []string{}
+	for name := range set.Secrets {
+		names = append(names, name)
+	}
+	return p.deleteSecrets(ctx, set.ProjectId, names)
+}
+
+// upsertSecrets writes in a single call the secrets that differ from the previously applied ones
+func (p *supabaseProvider) upsertSecrets(ctx context.Context, projectId string, secrets, previous map[string]string) error {
+	body := client.CreateSecretsJSONRequestBody{}
+	for name, value := range secrets {
+		if previousValue, ok := previous[name]; !ok || previousValue != value {
+			body = append(body, client.CreateSecretBody{Name: name, Value: value})
+		}
+	}
+	if len(body) == 0 {
+		return nil
+	}
+	sort.Slice(body, func(i, j int) bool { return body[i].Name < body[j].Name })
+	res, err := p.supabase.CreateSecretsWithResponse(withRetrySafe(ctx), projectId, body)
+	return checkForSupabaseError(res, err)
+}
+
+// pruneSecrets deletes the secrets of the project not managed by the set when pruning is enabled
+func (p *supabaseProvider) pruneSecrets(ctx context.Context, set secretSet) error {
+	if !set.Prune {
+		return nil
+	}
+	secrets, err := p.supabase.GetSecretsWithResponse(ctx, set.ProjectId)
+	if err := checkForSupabaseError(secrets, err); err != nil {
+		return err
+	}
+	if secrets.JSON200 == nil {
+		return errUnexpectedResponse(secrets)
+	}
+	unmanaged := []string{}
+	for _, secret := range *secrets.JSON200 {
+		if _, ok := set.Secrets[secret.Name]; !ok && !strings.HasPrefix(secret.Name, reservedSecretPrefix) {
+			unmanaged = append(unmanaged, secret.Name)
+		}
+	}
+	return p.deleteSecrets(ctx, set.ProjectId, unmanaged)
+}
+
+func (p *supabaseProvider) deleteSecrets(ctx context.Context, projectId string, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	sort.Strings(names)
+	res, err := p.supabase.DeleteSecretsWithResponse(ctx, projectId, names)
+	return checkForSupabaseError(res, err)
+}
+
+func secretDigests(secrets map[string]string) map[string]string {
+	digests := map[string]string{}
+	for name, value := range secrets {
+		digests[name] = secretDigest(value)
+	}
+	return digests
+}
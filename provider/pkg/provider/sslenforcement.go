@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/LuxChanLu/pulumi-supabase/pkg/provider/client"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+// sslEnforcementArgs are the inputs of the SSL enforcement of a project
+type sslEnforcementArgs struct {
+	ProjectId string `json:"projectId"`
+	Database  bool   `json:"database"`
+}
+
+// sslEnforcementState are the outputs of the SSL enforcement of a project
+type sslEnforcementState struct {
+	sslEnforcementArgs
+	AppliedSuccessfully bool `json:"appliedSuccessfully"`
+}
+
+func checkSslEnforcement(inputs resource.PropertyMap) []*pulumirpc.CheckFailure {
+	return checkNotEmpty(inputs, "projectId")
+}
+
+func newSslEnforcementState(projectId string, enforcement client.SslEnforcementResponse) *sslEnforcementState {
+	return &sslEnforcementState{
+		sslEnforcementArgs:  sslEnforcementArgs{ProjectId: projectId, Database: enforcement.CurrentConfig.Database},
+		AppliedSuccessfully: enforcement.AppliedSuccessfully,
+	}
+}
+
+func (p *supabaseProvider) createSslEnforcement(ctx context.Context, inputs resource.PropertyMap, preview bool) (string, *sslEnforcementState, error) {
+	state, err := p.updateSslEnforcement(ctx, inputs, preview)
+	if err != nil || preview {
+		return "", state, err
+	}
+	return state.ProjectId, state, nil
+}
+
+func (p *supabaseProvider) readSslEnforcement(ctx context.Context, projectId string) (string, *sslEnforcementState, error) {
+	res, err := p.supabase.GetSslEnforcementConfigWithResponse(ctx, projectId)
+	if err := checkForSupabaseError(res, err); err != nil {
+		if isNotFound(err) {
+			return "", nil, nil
+		}
+		return "", nil, err
+	}
+	if res.JSON200 == nil {
+		return "", nil, errUnexpectedResponse(res)
+	}
+	return projectId, newSslEnforcementState(projectId, *res.JSON200), nil
+}
+
+func (p *supabaseProvider) updateSslEnforcement(ctx context.Context, inputs resource.PropertyMap, preview bool) (*sslEnforcementState, error) {
+	args := sslEnforcementArgs{}
+	if err := propertiesMapToStruct(inputs, &args); err != nil {
+		return nil, err
+	}
+	if preview {
+		return &sslEnforcementState{sslEnforcementArgs: args}, nil
+	}
+	return p.applySslEnforcement(ctx, args)
+}
+
+// deleteSslEnforcement stops enforcing SSL as on a new project, the enforcement itself cannot be deleted
+func (p *supabaseProvider) deleteSslEnforcement(ctx context.Context, projectId string) error {
+	_, err := p.applySslEnforcement(ctx, sslEnforcementArgs{ProjectId: projectId})
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (p *supabaseProvider) applySslEnforcement(ctx context.Context, args sslEnforcementArgs) (*sslEnforcementState, error) {
+	body := client.UpdateSslEnforcementConfigJSONRequestBody{RequestedConfig: client.SslEnforcements{Database: args.Database}}
+	res, err := p.supabase.UpdateSslEnforcementConfigWithResponse(withRetrySafe(ctx), args.ProjectId, body)
+	if err := checkForSupabaseError(res, err); err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil {
+		return nil, errUnexpectedResponse(res)
+	}
+	return newSslEnforcementState(args.ProjectId, *res.JSON200), nil
+}
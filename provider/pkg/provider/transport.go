@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const defaultMaxRetries = 3
+const defaultRetryBaseDelay = time.Second
+const defaultRetryMaxDelay = 30 * time.Second
+const defaultMaxRequestsPerSecond = 1.0
+
+type retrySafeKey struct{}
+
+// withRetrySafe marks the calls made with the returned context as safe to retry even if their method is not idempotent
+func withRetrySafe(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retrySafeKey{}, true)
+}
+
+// retryTransport retries rate limited and transient failures with an exponential backoff and full jitter
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	baseDelay  time.Duration
+	maxDelay   time.Duration
+	log        func(ctx context.Context, message string)
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		res, err := t.next.RoundTrip(req)
+		if attempt >= t.maxRetries || !t.shouldRetry(req, res, err) {
+			return res, err
+		}
+
+		retryReq, ok := rewindRequest(req)
+		if !ok {
+			return res, err
+		}
+		delay := t.backoff(attempt, res)
+		reason := ""
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = res.Status
+			res.Body.Close()
+		}
+		if t.log != nil {
+			t.log(req.Context(), fmt.Sprintf("supabase: %s %s failed (%s), retrying in %s (attempt %d/%d)", req.Method, req.URL.Path, reason, delay.Round(time.Millisecond), attempt+1, t.maxRetries))
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+		req = retryReq
+	}
+}
+
+func (t *retryTransport) shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	// A rate limited request has not been processed, it is always safe to send it again
+	if err == nil && res.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if !isIdempotent(req) {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	switch res.StatusCode {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func (t *retryTransport) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		// The delay asked by the server is honoured up to maxDelay so a long Retry-After cannot stall an operation
+		if delay, ok := retryAfter(res.Header.Get("Retry-After")); ok {
+			if delay > t.maxDelay {
+				delay = t.maxDelay
+			}
+			return delay
+		}
+	}
+	delay := t.baseDelay << attempt
+	if delay <= 0 || delay > t.maxDelay {
+		delay = t.maxDelay
+	}
+	// Full jitter so parallel resource operations do not retry in lockstep
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
+
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	safe, _ := req.Context().Value(retrySafeKey{}).(bool)
+	return safe
+}
+
+// rewindRequest clones the request with a fresh body so it can be sent again
+func rewindRequest(req *http.Request) (*http.Request, bool) {
+	retryReq := req.Clone(req.Context())
+	if req.Body == nil || req.Body == http.NoBody {
+		return retryReq, true
+	}
+	if req.GetBody == nil {
+		return nil, false
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, false
+	}
+	retryReq.Body = body
+	return retryReq, true
+}
+
+// retryAfter parses a Retry-After header given either in seconds or as an HTTP date
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// rateLimitTransport throttles every request sent by the provider, it is shared by all concurrent resource operations
+type rateLimitTransport struct {
+	next    http.RoundTripper
+	limiter *rate.Limiter
+}
+
+func newRateLimitTransport(next http.RoundTripper, requestsPerSecond float64) http.RoundTripper {
+	if requestsPerSecond <= 0 {
+		return next
+	}
+	burst := int(requestsPerSecond)
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimitTransport{next: next, limiter: rate.NewLimiter(rate.Limit(requestsPerSecond), burst)}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// requestCounter is a stand-in for the Management API recording when every request is received
+type requestCounter struct {
+	mu    sync.Mutex
+	times []time.Time
+}
+
+func (c *requestCounter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	c.times = append(c.times, time.Now())
+	c.mu.Unlock()
+	w.WriteHeader(http.StatusOK)
+}
+
+// maxInWindow is the largest number of requests received within any window
+func (c *requestCounter) maxInWindow(window time.Duration) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	max := 0
+	for i, start := range c.times {
+		count := 0
+		for _, t := range c.times[i:] {
+			if t.Sub(start) < window {
+				count++
+			}
+		}
+		if count > max {
+			max = count
+		}
+	}
+	return max
+}
+
+func sendRequests(t *testing.T, client *http.Client, url string, count int) {
+	for i := 0; i < count; i++ {
+		res, err := client.Get(url)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		res.Body.Close()
+	}
+}
+
+func TestRateLimitTransportThrottlesRequests(t *testing.T) {
+	counter := &requestCounter{}
+	server := httptest.NewServer(counter)
+	defer server.Close()
+
+	client := &http.Client{Transport: newRateLimitTransport(http.DefaultTransport, 20)}
+	start := time.Now()
+	sendRequests(t, client, server.URL, 40)
+
+	// The burst of 20 is sent at once, the 20 others at 20 per second
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Errorf("40 requests at 20/s took %s, expected about 1s", elapsed)
+	}
+	if max := counter.maxInWindow(500 * time.Millisecond); max > 31 {
+		t.Errorf("%d requests received within 500ms, expected at most 31", max)
+	}
+}
+
+func TestRateLimitTransportIsSharedAcrossGoroutines(t *testing.T) {
+	counter := &requestCounter{}
+	server := httptest.NewServer(counter)
+	defer server.Close()
+
+	// Every resource operation runs in its own goroutine with the transport of the provider
+	transport := newRateLimitTransport(http.DefaultTransport, 20)
+	start := time.Now()
+	wg := sync.WaitGroup{}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sendRequests(t, &http.Client{Transport: transport}, server.URL, 10)
+		}()
+	}
+	wg.Wait()
+
+	if len(counter.times) != 40 {
+		t.Fatalf("%d requests received, expected 40", len(counter.times))
+	}
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Errorf("40 concurrent requests at 20/s took %s, expected about 1s", elapsed)
+	}
+	if max := counter.maxInWindow(500 * time.Millisecond); max > 31 {
+		t.Errorf("%d requests received within 500ms, expected at most 31", max)
+	}
+}
+
+func TestRateLimitTransportDisabled(t *testing.T) {
+	if transport := newRateLimitTransport(http.DefaultTransport, 0); transport != http.DefaultTransport {
+		t.Errorf("a limit of 0 should not wrap the transport, got %T", transport)
+	}
+}
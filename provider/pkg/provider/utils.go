@@ -3,10 +3,16 @@ package provider
 import (
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"reflect"
+	"strings"
+	"time"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/rpcutil/rpcerror"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+	"google.golang.org/grpc/codes"
 )
 
 func propertiesMapToStruct(inputs resource.PropertyMap, output interface{}) error {
@@ -31,27 +37,102 @@ func propertiesMapToStruct(inputs resource.PropertyMap, output interface{}) erro
 	return json.Unmarshal(jsonData, output)
 }
 
-func structToOutputs(inputs interface{}, output *map[string]interface{}) error {
-	// TODO: Remove ID from output
-	jsonData, err := json.Marshal(inputs)
+// stateToOutputs encodes the typed state of a resource into its outputs, the secret and unknown markers of the
+// inputs are carried over to the outputs of the same name
+func stateToOutputs(state interface{}, inputs resource.PropertyMap) (resource.PropertyMap, error) {
+	outputs := resource.PropertyMap{}
+	if value := reflect.ValueOf(state); !value.IsValid() || (value.Kind() == reflect.Ptr && value.IsNil()) {
+		return outputs, nil
+	}
+	jsonData, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]interface{}{}
+	if err := json.Unmarshal(jsonData, &values); err != nil {
+		return nil, err
+	}
+	outputs = resource.NewPropertyMapFromMap(values)
+	for key, input := range inputs {
+		output, ok := outputs[key]
+		switch {
+		case !ok:
+		case input.ContainsUnknowns():
+			outputs[key] = input
+		case input.ContainsSecrets() && !output.IsSecret():
+			outputs[key] = resource.MakeSecret(output)
+		}
+	}
+	return outputs, nil
+}
+
+// formatTimestamp formats the millisecond timestamps of the API as RFC 3339 dates
+func formatTimestamp(milliseconds float64) string {
+	if milliseconds == 0 {
+		return ""
+	}
+	return time.UnixMilli(int64(milliseconds)).UTC().Format(time.RFC3339)
+}
+
+// projectResourceId builds the "<projectRef>/<key>" ID of a resource living in a project
+func projectResourceId(projectId, key string) string {
+	return fmt.Sprintf("%s/%s", projectId, key)
+}
+
+// parseProjectResourceId reads the project and key of a project resource from its state, or from its ID when the
+// state is empty as it is during `pulumi import`
+func parseProjectResourceId(id string, state resource.PropertyMap, key resource.PropertyKey) (string, string, error) {
+	if state["projectId"].IsString() && state[key].IsString() {
+		return state["projectId"].StringValue(), state[key].StringValue(), nil
+	}
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid id '%s', expected <projectRef>/<%s>", id, key)
+	}
+	return parts[0], parts[1], nil
+}
+
+// resourceInitError reports a resource that was created but failed to initialize so Pulumi keeps it in the state
+func resourceInitError(id string, outputs resource.PropertyMap, reason error) error {
+	properties, err := plugin.MarshalProperties(outputs, plugin.MarshalOptions{KeepUnknowns: true, SkipNulls: true, KeepSecrets: true})
 	if err != nil {
 		return err
 	}
-	return json.Unmarshal(jsonData, output)
+	return rpcerror.WithDetails(rpcerror.New(codes.Unknown, reason.Error()), &pulumirpc.ErrorResourceInitFailed{
+		Id:         id,
+		Properties: properties,
+		Reasons:    []string{reason.Error()},
+	})
 }
 
-func checkForSupabaseError(res *http.Response, err error) error {
+// checkForSupabaseError turns a failed call or a non 2xx generated *Response into an error
+func checkForSupabaseError(res interface{}, err error) error {
 	if err != nil {
 		return err
 	}
-	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
-		body, err := io.ReadAll(res.Body)
-		if err != nil {
-			defer res.Body.Close()
-			return fmt.Errorf("HTTP Error: %s, %s", res.Status, string(body))
-		}
-		// TODO: Get error content
-		return fmt.Errorf("HTTP Error: %s", res.Status)
+	httpResponse, body := rawResponse(res)
+	if httpResponse == nil {
+		return fmt.Errorf("no response from supabase")
+	}
+	if httpResponse.StatusCode < http.StatusOK || httpResponse.StatusCode >= http.StatusBadRequest {
+		return newSupabaseError(httpResponse, body)
 	}
 	return nil
 }
+
+// rawResponse extracts the HTTPResponse and Body fields shared by every generated *Response
+func rawResponse(res interface{}) (*http.Response, []byte) {
+	value := reflect.ValueOf(res)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return nil, nil
+	}
+	var httpResponse *http.Response
+	var body []byte
+	if field := value.Elem().FieldByName("HTTPResponse"); field.IsValid() {
+		httpResponse, _ = field.Interface().(*http.Response)
+	}
+	if field := value.Elem().FieldByName("Body"); field.IsValid() {
+		body, _ = field.Interface().([]byte)
+	}
+	return httpResponse, body
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/LuxChanLu/pulumi-supabase/pkg/provider/client"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+var vanitySubdomainPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$`)
+
+// vanitySubdomainArgs are the inputs of the vanity subdomain of a project
+type vanitySubdomainArgs struct {
+	ProjectId string `json:"projectId"`
+	Subdomain string `json:"subdomain"`
+}
+
+// vanitySubdomainState are the outputs of the vanity subdomain of a project
+type vanitySubdomainState struct {
+	vanitySubdomainArgs
+	CustomDomain string `json:"customDomain"`
+	Status       string `json:"status"`
+}
+
+func checkVanitySubdomain(inputs resource.PropertyMap) []*pulumirpc.CheckFailure {
+	subdomain, ok := stringInput(inputs, "subdomain")
+	if ok && !vanitySubdomainPattern.MatchString(subdomain) {
+		return []*pulumirpc.CheckFailure{checkFailure("subdomain", "%s must be 3 to 63 lowercase letters, digits or hyphens, not starting nor ending with a hyphen", subdomain)}
+	}
+	return nil
+}
+
+// checkVanitySubdomainAvailability fails the preview early when the subdomain is taken by another project
+func (p *supabaseProvider) checkVanitySubdomainAvailability(ctx context.Context, olds, news resource.PropertyMap) (*pulumirpc.CheckFailure, error) {
+	projectId, okProject := stringInput(news, "projectId")
+	subdomain, okSubdomain := stringInput(news, "subdomain")
+	if !okProject || !okSubdomain {
+		return nil, nil
+	}
+	if oldProjectId, _ := stringInput(olds, "projectId"); oldProjectId == projectId {
+		if oldSubdomain, _ := stringInput(olds, "subdomain"); oldSubdomain == subdomain {
+			return nil, nil
+		}
+	}
+
+	res, err := p.supabase.CheckVanitySubdomainAvailabilityWithResponse(withRetrySafe(ctx), projectId, client.CheckVanitySubdomainAvailabilityJSONRequestBody{VanitySubdomain: subdomain})
+	if err := checkForSupabaseError(res, err); err != nil {
+		return nil, err
+	}
+	if res.JSON201 == nil {
+		return nil, errUnexpectedResponse(res)
+	}
+	if res.JSON201.Available {
+		return nil, nil
+	}
+	// A subdomain already used by the project, as when it is imported, is not available either
+	_, current, err := p.readVanitySubdomain(ctx, projectId)
+	if err != nil {
+		return nil, err
+	}
+	if current != nil && current.Subdomain == subdomain {
+		return nil, nil
+	}
+	return checkFailure("subdomain", "%s.supabase.co is not available", subdomain), nil
+}
+
+func newVanitySubdomainState(projectId string, customDomain string, status client.VanitySubdomainConfigResponseStatus) *vanitySubdomainState {
+	return &vanitySubdomainState{
+		vanitySubdomainArgs: vanitySubdomainArgs{ProjectId: projectId, Subdomain: strings.TrimSuffix(customDomain, ".supabase.co")},
+		CustomDomain:        customDomain,
+		Status:              string(status),
+	}
+}
+
+func (p *supabaseProvider) createVanitySubdomain(ctx context.Context, inputs resource.PropertyMap, preview bool) (string, *vanitySubdomainState, error) {
+	args := vanitySubdomainArgs{}
+	if err := propertiesMapToStruct(inputs, &args); err != nil {
+		return "", nil, err
+	}
+	if preview {
+		return "", &vanitySubdomainState{vanitySubdomainArgs: args, CustomDomain: fmt.Sprintf("%s.supabase.co", args.Subdomain)}, nil
+	}
+	res, err := p.supabase.ActivateVanitySubdomainPleaseWithResponse(withRetrySafe(ctx), args.ProjectId, client.ActivateVanitySubdomainPleaseJSONRequestBody{VanitySubdomain: args.Subdomain})
+	if err := checkForSupabaseError(res, err); err != nil {
+		return "", nil, err
+	}
+	if res.JSON201 == nil {
+		return "", nil, errUnexpectedResponse(res)
+	}
+	state := newVanitySubdomainState(args.ProjectId, res.JSON201.CustomDomain, client.Active)
+	state.Subdomain = args.Subdomain
+	return args.ProjectId, state, nil
+}
+
+func (p *supabaseProvider) readVanitySubdomain(ctx context.Context, projectId string) (string, *vanitySubdomainState, error) {
+	res, err := p.supabase.GetVanitySubdomainConfigWithResponse(ctx, projectId)
+	if err := checkForSupabaseError(res, err); err != nil {
+		if isNotFound(err) {
+			return "", nil, nil
+		}
+		return "", nil, err
+	}
+	if res.JSON200 == nil {
+		return "", nil, errUnexpectedResponse(res)
+	}
+	if res.JSON200.Status == client.NotUsed || res.JSON200.CustomDomain == nil {
+		return "", nil, nil
+	}
+	return projectId, newVanitySubdomainState(projectId, *res.JSON200.CustomDomain, res.JSON200.Status), nil
+}
+
+func (p *supabaseProvider) deleteVanitySubdomain(ctx context.Context, projectId string) error {
+	res, err := p.supabase.RemoveVanitySubdomainConfigWithResponse(ctx, projectId)
+	if err := checkForSupabaseError(res, err); err != nil && !isNotFound(err) {
+		return err
+	}
+	return nil
+}
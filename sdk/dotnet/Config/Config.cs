@@ -32,6 +32,46 @@ namespace Pulumi.Supabase
 
         private static readonly Pulumi.Config __config = new Pulumi.Config("supabase");
 
+        private static readonly __Value<double?> _maxRequestsPerSecond = new __Value<double?>(() => __config.GetDouble("maxRequestsPerSecond") ?? 1);
+        /// <summary>
+        /// Client side limit of Management API requests per second shared by all resource operations, 0 disables it
+        /// </summary>
+        public static double? MaxRequestsPerSecond
+        {
+            get => _maxRequestsPerSecond.Get();
+            set => _maxRequestsPerSecond.Set(value);
+        }
+
+        private static readonly __Value<int?> _maxRetries = new __Value<int?>(() => __config.GetInt32("maxRetries") ?? 3);
+        /// <summary>
+        /// Maximum number of retries for rate limited (429) or transient Management API failures
+        /// </summary>
+        public static int? MaxRetries
+        {
+            get => _maxRetries.Get();
+            set => _maxRetries.Set(value);
+        }
+
+        private static readonly __Value<double?> _retryBaseDelay = new __Value<double?>(() => __config.GetDouble("retryBaseDelay") ?? 1);
+        /// <summary>
+        /// Initial delay in seconds between retries, doubled on every attempt with jitter
+        /// </summary>
+        public static double? RetryBaseDelay
+        {
+            get => _retryBaseDelay.Get();
+            set => _retryBaseDelay.Set(value);
+        }
+
+        private static readonly __Value<double?> _retryMaxDelay = new __Value<double?>(() => __config.GetDouble("retryMaxDelay") ?? 30);
+        /// <summary>
+        /// Maximum delay in seconds between retries, a longer Retry-After from the API is capped to it
+        /// </summary>
+        public static double? RetryMaxDelay
+        {
+            get => _retryMaxDelay.Get();
+            set => _retryMaxDelay.Set(value);
+        }
+
         private static readonly __Value<string?> _server = new __Value<string?>(() => __config.Get("server") ?? "https://api.supabase.com/");
         /// <summary>
         /// Supabase server (https://api.supabase.com/)
@@ -0,0 +1,123 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Supabase
+{
+    /// <summary>
+    /// Custom hostname of a project. The `dnsRecords` output lists the CNAME and TXT records to create at the DNS provider
+    /// of the hostname. Once they exist, set `waitForVerification` to wait for the hostname to be verified and activate it.
+    /// Do not set it on creation when the DNS records depend on this resource, the verification would never succeed.
+    /// Deleting the resource removes the custom hostname.
+    /// 
+    /// The custom hostname of a project can be imported with its reference:
+    /// `pulumi import supabase:index:CustomHostname api &lt;projectRef&gt;`
+    /// </summary>
+    [SupabaseResourceType("supabase:index:CustomHostname")]
+    public partial class CustomHostname : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Whether the hostname is verified and serves the project
+        /// </summary>
+        [Output("activated")]
+        public Output<bool> Activated { get; private set; } = null!;
+
+        /// <summary>
+        /// DNS records to create for the hostname to be verified
+        /// </summary>
+        [Output("dnsRecords")]
+        public Output<ImmutableArray<Outputs.DnsRecord>> DnsRecords { get; private set; } = null!;
+
+        /// <summary>
+        /// Custom hostname of the project, such as api.example.com
+        /// </summary>
+        [Output("hostname")]
+        public Output<string> Hostname { get; private set; } = null!;
+
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        [Output("projectId")]
+        public Output<string> ProjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// Verification status of the hostname (1_not_started to 5_services_reconfigured)
+        /// </summary>
+        [Output("status")]
+        public Output<string> Status { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a CustomHostname resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public CustomHostname(string name, CustomHostnameArgs args, CustomResourceOptions? options = null)
+            : base("supabase:index:CustomHostname", name, args ?? new CustomHostnameArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private CustomHostname(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("supabase:index:CustomHostname", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/LuxChanLu",
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing CustomHostname resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static CustomHostname Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new CustomHostname(name, id, options);
+        }
+    }
+
+    public sealed class CustomHostnameArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Custom hostname of the project, such as api.example.com
+        /// </summary>
+        [Input("hostname", required: true)]
+        public Input<string> Hostname { get; set; } = null!;
+
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        [Input("projectId", required: true)]
+        public Input<string> ProjectId { get; set; } = null!;
+
+        /// <summary>
+        /// Whether to wait for the DNS records to be verified and then activate the hostname
+        /// </summary>
+        [Input("waitForVerification")]
+        public Input<bool>? WaitForVerification { get; set; }
+
+        public CustomHostnameArgs()
+        {
+            WaitForVerification = false;
+        }
+    }
+}
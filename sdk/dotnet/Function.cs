@@ -9,27 +9,75 @@ using Pulumi.Serialization;
 
 namespace Pulumi.Supabase
 {
+    /// <summary>
+    /// Edge function of a project.
+    /// 
+    /// Existing functions can be imported with their project reference and slug:
+    /// `pulumi import supabase:index:Function my-function &lt;projectRef&gt;/&lt;slug&gt;`
+    /// </summary>
     [SupabaseResourceType("supabase:index:Function")]
     public partial class Function : Pulumi.CustomResource
     {
+        /// <summary>
+        /// SHA-256 of the deployed body, stored in place of the body itself
+        /// </summary>
+        [Output("bodyHash")]
+        public Output<string?> BodyHash { get; private set; } = null!;
+
         /// <summary>
         /// Function creation date
         /// </summary>
         [Output("created_at")]
         public Output<string> Created_at { get; private set; } = null!;
 
+        /// <summary>
+        /// Entrypoint of the function
+        /// </summary>
+        [Output("entrypointPath")]
+        public Output<string?> EntrypointPath { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether the function is deployed with an import map
+        /// </summary>
+        [Output("hasImportMap")]
+        public Output<bool?> HasImportMap { get; private set; } = null!;
+
+        /// <summary>
+        /// Import map of the function, inline or as the path to its file
+        /// </summary>
+        [Output("importMap")]
+        public Output<string?> ImportMap { get; private set; } = null!;
+
+        /// <summary>
+        /// Path the import map is deployed to
+        /// </summary>
+        [Output("importMapPath")]
+        public Output<string?> ImportMapPath { get; private set; } = null!;
+
         /// <summary>
         /// Name of the function
         /// </summary>
         [Output("name")]
         public Output<string> Name { get; private set; } = null!;
 
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        [Output("projectId")]
+        public Output<string?> ProjectId { get; private set; } = null!;
+
         /// <summary>
         /// Slug of the function
         /// </summary>
         [Output("slug")]
         public Output<string> Slug { get; private set; } = null!;
 
+        /// <summary>
+        /// SHA-256 of the deployed source bundle
+        /// </summary>
+        [Output("sourceHash")]
+        public Output<string?> SourceHash { get; private set; } = null!;
+
         /// <summary>
         /// Status of the function
         /// </summary>
@@ -100,7 +148,7 @@ namespace Pulumi.Supabase
 
     public sealed class FunctionArgs : Pulumi.ResourceArgs
     {
-        [Input("body", required: true)]
+        [Input("body")]
         private Input<string>? _body;
 
         /// <summary>
@@ -116,6 +164,24 @@ namespace Pulumi.Supabase
             }
         }
 
+        /// <summary>
+        /// Entrypoint of the function relative to its source directory (index.ts by default), or the file name body is deployed as
+        /// </summary>
+        [Input("entrypointPath")]
+        public Input<string>? EntrypointPath { get; set; }
+
+        /// <summary>
+        /// Import map of the function, given inline as JSON or as the path to its file. It replaces the import_map.json or deno.json found next to the entrypoint
+        /// </summary>
+        [Input("importMap")]
+        public Input<string>? ImportMap { get; set; }
+
+        /// <summary>
+        /// Path the import map is deployed to, relative to the entrypoint directory. Its relative mappings are rewritten to point to the same modules. By default an import map file keeps its place relative to the modules
+        /// </summary>
+        [Input("importMapPath")]
+        public Input<string>? ImportMapPath { get; set; }
+
         /// <summary>
         /// Name of the function
         /// </summary>
@@ -134,6 +200,12 @@ namespace Pulumi.Supabase
         [Input("slug", required: true)]
         public Input<string> Slug { get; set; } = null!;
 
+        /// <summary>
+        /// Local directory (with an index.ts entrypoint) or entrypoint file of the function, its local imports and import map are bundled and deployed in place of body
+        /// </summary>
+        [Input("source")]
+        public Input<string>? Source { get; set; }
+
         /// <summary>
         /// Verify JWT before running
         /// </summary>
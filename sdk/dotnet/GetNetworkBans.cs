@@ -0,0 +1,77 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Supabase
+{
+    public static class GetNetworkBans
+    {
+        /// <summary>
+        /// Lists the IPv4 addresses banned by the database of a project
+        /// </summary>
+        public static Task<GetNetworkBansResult> InvokeAsync(GetNetworkBansArgs args, InvokeOptions? options = null)
+            => Pulumi.Deployment.Instance.InvokeAsync<GetNetworkBansResult>("supabase:index:getNetworkBans", args ?? new GetNetworkBansArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the IPv4 addresses banned by the database of a project
+        /// </summary>
+        public static Output<GetNetworkBansResult> Invoke(GetNetworkBansInvokeArgs args, InvokeOptions? options = null)
+            => Pulumi.Deployment.Instance.Invoke<GetNetworkBansResult>("supabase:index:getNetworkBans", args ?? new GetNetworkBansInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetNetworkBansArgs : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        [Input("projectId", required: true)]
+        public string ProjectId { get; set; } = null!;
+
+        public GetNetworkBansArgs()
+        {
+        }
+    }
+
+    public sealed class GetNetworkBansInvokeArgs : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        [Input("projectId", required: true)]
+        public Input<string> ProjectId { get; set; } = null!;
+
+        public GetNetworkBansInvokeArgs()
+        {
+        }
+    }
+
+
+    [OutputType]
+    public sealed class GetNetworkBansResult
+    {
+        /// <summary>
+        /// Banned IPv4 addresses
+        /// </summary>
+        public readonly ImmutableArray<string> BannedIpv4Addresses;
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        public readonly string ProjectId;
+
+        [OutputConstructor]
+        private GetNetworkBansResult(
+            ImmutableArray<string> bannedIpv4Addresses,
+
+            string projectId)
+        {
+            BannedIpv4Addresses = bannedIpv4Addresses;
+            ProjectId = projectId;
+        }
+    }
+}
@@ -0,0 +1,37 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Supabase.Inputs
+{
+
+    public sealed class ProjectAuthEmailArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Confirm the email of new users without sending a confirmation email
+        /// </summary>
+        [Input("autoconfirm")]
+        public Input<bool>? Autoconfirm { get; set; }
+
+        /// <summary>
+        /// Allow users to sign up and sign in with their email
+        /// </summary>
+        [Input("enabled")]
+        public Input<bool>? Enabled { get; set; }
+
+        /// <summary>
+        /// Custom SMTP server the auth emails are sent with
+        /// </summary>
+        [Input("smtp")]
+        public Input<Inputs.ProjectAuthSmtpArgs>? Smtp { get; set; }
+
+        public ProjectAuthEmailArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,53 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Supabase.Inputs
+{
+
+    public sealed class ProjectAuthExternalProviderArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// OAuth client ID
+        /// </summary>
+        [Input("clientId")]
+        public Input<string>? ClientId { get; set; }
+
+        /// <summary>
+        /// Allow users to sign in with the provider
+        /// </summary>
+        [Input("enabled")]
+        public Input<bool>? Enabled { get; set; }
+
+        [Input("secret")]
+        private Input<string>? _secret;
+
+        /// <summary>
+        /// OAuth client secret
+        /// </summary>
+        public Input<string>? Secret
+        {
+            get => _secret;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _secret = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// URL of the provider, for the self-hosted ones (azure, gitlab, keycloak, workos)
+        /// </summary>
+        [Input("url")]
+        public Input<string>? Url { get; set; }
+
+        public ProjectAuthExternalProviderArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,43 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Supabase.Inputs
+{
+
+    public sealed class ProjectAuthSmsArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Confirm the phone number of new users without sending a confirmation SMS
+        /// </summary>
+        [Input("autoconfirm")]
+        public Input<bool>? Autoconfirm { get; set; }
+
+        /// <summary>
+        /// Allow users to sign up and sign in with their phone number
+        /// </summary>
+        [Input("enabled")]
+        public Input<bool>? Enabled { get; set; }
+
+        /// <summary>
+        /// SMS provider (twilio, twilio_verify, messagebird, textlocal or vonage)
+        /// </summary>
+        [Input("provider")]
+        public Input<string>? Provider { get; set; }
+
+        /// <summary>
+        /// Twilio credentials, used by the twilio provider
+        /// </summary>
+        [Input("twilio")]
+        public Input<Inputs.ProjectAuthTwilioArgs>? Twilio { get; set; }
+
+        public ProjectAuthSmsArgs()
+        {
+        }
+    }
+}
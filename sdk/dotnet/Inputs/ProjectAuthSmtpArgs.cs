@@ -0,0 +1,65 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Supabase.Inputs
+{
+
+    public sealed class ProjectAuthSmtpArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Email address the auth emails are sent from
+        /// </summary>
+        [Input("adminEmail")]
+        public Input<string>? AdminEmail { get; set; }
+
+        /// <summary>
+        /// Hostname of the SMTP server
+        /// </summary>
+        [Input("host")]
+        public Input<string>? Host { get; set; }
+
+        [Input("pass")]
+        private Input<string>? _pass;
+
+        /// <summary>
+        /// Password of the SMTP server
+        /// </summary>
+        public Input<string>? Pass
+        {
+            get => _pass;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _pass = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// Port of the SMTP server
+        /// </summary>
+        [Input("port")]
+        public Input<int>? Port { get; set; }
+
+        /// <summary>
+        /// Name the auth emails are sent from
+        /// </summary>
+        [Input("senderName")]
+        public Input<string>? SenderName { get; set; }
+
+        /// <summary>
+        /// Username of the SMTP server
+        /// </summary>
+        [Input("user")]
+        public Input<string>? User { get; set; }
+
+        public ProjectAuthSmtpArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,47 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Supabase.Inputs
+{
+
+    public sealed class ProjectAuthTwilioArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Twilio account SID
+        /// </summary>
+        [Input("accountSid")]
+        public Input<string>? AccountSid { get; set; }
+
+        [Input("authToken")]
+        private Input<string>? _authToken;
+
+        /// <summary>
+        /// Twilio auth token
+        /// </summary>
+        public Input<string>? AuthToken
+        {
+            get => _authToken;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _authToken = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// Twilio message service SID
+        /// </summary>
+        [Input("messageServiceSid")]
+        public Input<string>? MessageServiceSid { get; set; }
+
+        public ProjectAuthTwilioArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,124 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Supabase
+{
+    /// <summary>
+    /// Lifts the network bans of a set of IPv4 addresses of a project, the database bans addresses after repeated
+    /// failed connections. The bans are lifted on creation and again whenever the addresses or the triggers change,
+    /// deleting the resource does not ban the addresses again.
+    /// </summary>
+    [SupabaseResourceType("supabase:index:NetworkBanRemoval")]
+    public partial class NetworkBanRemoval : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// IPv4 addresses whose bans are lifted
+        /// </summary>
+        [Output("ipv4Addresses")]
+        public Output<ImmutableArray<string>> Ipv4Addresses { get; private set; } = null!;
+
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        [Output("projectId")]
+        public Output<string> ProjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// Values which lift the bans again whenever they change, such as a deployment ID
+        /// </summary>
+        [Output("triggers")]
+        public Output<ImmutableArray<object>> Triggers { get; private set; } = null!;
+
+        /// <summary>
+        /// Addresses which were banned when the bans were last lifted
+        /// </summary>
+        [Output("unbannedIpv4Addresses")]
+        public Output<ImmutableArray<string>> UnbannedIpv4Addresses { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a NetworkBanRemoval resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public NetworkBanRemoval(string name, NetworkBanRemovalArgs args, CustomResourceOptions? options = null)
+            : base("supabase:index:NetworkBanRemoval", name, args ?? new NetworkBanRemovalArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private NetworkBanRemoval(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("supabase:index:NetworkBanRemoval", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/LuxChanLu",
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing NetworkBanRemoval resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static NetworkBanRemoval Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new NetworkBanRemoval(name, id, options);
+        }
+    }
+
+    public sealed class NetworkBanRemovalArgs : Pulumi.ResourceArgs
+    {
+        [Input("ipv4Addresses", required: true)]
+        private InputList<string>? _ipv4Addresses;
+
+        /// <summary>
+        /// IPv4 addresses whose bans are lifted
+        /// </summary>
+        public InputList<string> Ipv4Addresses
+        {
+            get => _ipv4Addresses ?? (_ipv4Addresses = new InputList<string>());
+            set => _ipv4Addresses = value;
+        }
+
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        [Input("projectId", required: true)]
+        public Input<string> ProjectId { get; set; } = null!;
+
+        [Input("triggers")]
+        private InputList<object>? _triggers;
+
+        /// <summary>
+        /// Values which lift the bans again whenever they change, such as a deployment ID
+        /// </summary>
+        public InputList<object> Triggers
+        {
+            get => _triggers ?? (_triggers = new InputList<object>());
+            set => _triggers = value;
+        }
+
+        public NetworkBanRemovalArgs()
+        {
+        }
+    }
+}
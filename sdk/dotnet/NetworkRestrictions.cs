@@ -0,0 +1,113 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Supabase
+{
+    /// <summary>
+    /// Network restrictions of the database of a project. Deleting the resource allows every address again.
+    /// 
+    /// The restrictions of a project can be imported with its reference:
+    /// `pulumi import supabase:index:NetworkRestrictions db &lt;projectRef&gt;`
+    /// </summary>
+    [SupabaseResourceType("supabase:index:NetworkRestrictions")]
+    public partial class NetworkRestrictions : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// IPv4 and IPv6 CIDRs allowed to connect to the database, they must not overlap
+        /// </summary>
+        [Output("dbAllowedCidrs")]
+        public Output<ImmutableArray<string>> DbAllowedCidrs { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether the plan of the project allows network restrictions (allowed or disallowed)
+        /// </summary>
+        [Output("entitlement")]
+        public Output<string> Entitlement { get; private set; } = null!;
+
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        [Output("projectId")]
+        public Output<string> ProjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether the restrictions are applied or only stored
+        /// </summary>
+        [Output("status")]
+        public Output<string> Status { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a NetworkRestrictions resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public NetworkRestrictions(string name, NetworkRestrictionsArgs args, CustomResourceOptions? options = null)
+            : base("supabase:index:NetworkRestrictions", name, args ?? new NetworkRestrictionsArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private NetworkRestrictions(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("supabase:index:NetworkRestrictions", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/LuxChanLu",
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing NetworkRestrictions resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static NetworkRestrictions Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new NetworkRestrictions(name, id, options);
+        }
+    }
+
+    public sealed class NetworkRestrictionsArgs : Pulumi.ResourceArgs
+    {
+        [Input("dbAllowedCidrs", required: true)]
+        private InputList<string>? _dbAllowedCidrs;
+
+        /// <summary>
+        /// IPv4 and IPv6 CIDRs allowed to connect to the database, they must not overlap
+        /// </summary>
+        public InputList<string> DbAllowedCidrs
+        {
+            get => _dbAllowedCidrs ?? (_dbAllowedCidrs = new InputList<string>());
+            set => _dbAllowedCidrs = value;
+        }
+
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        [Input("projectId", required: true)]
+        public Input<string> ProjectId { get; set; } = null!;
+
+        public NetworkRestrictionsArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,45 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Supabase.Outputs
+{
+
+    /// <summary>
+    /// DNS record to create at the DNS provider of a hostname
+    /// </summary>
+    [OutputType]
+    public sealed class DnsRecord
+    {
+        /// <summary>
+        /// Name of the record
+        /// </summary>
+        public readonly string Name;
+        /// <summary>
+        /// Type of the record (CNAME or TXT)
+        /// </summary>
+        public readonly string Type;
+        /// <summary>
+        /// Value of the record
+        /// </summary>
+        public readonly string Value;
+
+        [OutputConstructor]
+        private DnsRecord(
+            string name,
+
+            string type,
+
+            string value)
+        {
+            Name = name;
+            Type = type;
+            Value = value;
+        }
+    }
+}
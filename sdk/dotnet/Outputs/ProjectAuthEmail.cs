@@ -0,0 +1,42 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Supabase.Outputs
+{
+
+    [OutputType]
+    public sealed class ProjectAuthEmail
+    {
+        /// <summary>
+        /// Confirm the email of new users without sending a confirmation email
+        /// </summary>
+        public readonly bool? Autoconfirm;
+        /// <summary>
+        /// Allow users to sign up and sign in with their email
+        /// </summary>
+        public readonly bool? Enabled;
+        /// <summary>
+        /// Custom SMTP server the auth emails are sent with
+        /// </summary>
+        public readonly Outputs.ProjectAuthSmtp? Smtp;
+
+        [OutputConstructor]
+        private ProjectAuthEmail(
+            bool? autoconfirm,
+
+            bool? enabled,
+
+            Outputs.ProjectAuthSmtp? smtp)
+        {
+            Autoconfirm = autoconfirm;
+            Enabled = enabled;
+            Smtp = smtp;
+        }
+    }
+}
@@ -0,0 +1,49 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Supabase.Outputs
+{
+
+    [OutputType]
+    public sealed class ProjectAuthExternalProvider
+    {
+        /// <summary>
+        /// OAuth client ID
+        /// </summary>
+        public readonly string? ClientId;
+        /// <summary>
+        /// Allow users to sign in with the provider
+        /// </summary>
+        public readonly bool? Enabled;
+        /// <summary>
+        /// OAuth client secret
+        /// </summary>
+        public readonly string? Secret;
+        /// <summary>
+        /// URL of the provider, for the self-hosted ones (azure, gitlab, keycloak, workos)
+        /// </summary>
+        public readonly string? Url;
+
+        [OutputConstructor]
+        private ProjectAuthExternalProvider(
+            string? clientId,
+
+            bool? enabled,
+
+            string? secret,
+
+            string? url)
+        {
+            ClientId = clientId;
+            Enabled = enabled;
+            Secret = secret;
+            Url = url;
+        }
+    }
+}
@@ -0,0 +1,49 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Supabase.Outputs
+{
+
+    [OutputType]
+    public sealed class ProjectAuthSms
+    {
+        /// <summary>
+        /// Confirm the phone number of new users without sending a confirmation SMS
+        /// </summary>
+        public readonly bool? Autoconfirm;
+        /// <summary>
+        /// Allow users to sign up and sign in with their phone number
+        /// </summary>
+        public readonly bool? Enabled;
+        /// <summary>
+        /// SMS provider (twilio, twilio_verify, messagebird, textlocal or vonage)
+        /// </summary>
+        public readonly string? Provider;
+        /// <summary>
+        /// Twilio credentials, used by the twilio provider
+        /// </summary>
+        public readonly Outputs.ProjectAuthTwilio? Twilio;
+
+        [OutputConstructor]
+        private ProjectAuthSms(
+            bool? autoconfirm,
+
+            bool? enabled,
+
+            string? provider,
+
+            Outputs.ProjectAuthTwilio? twilio)
+        {
+            Autoconfirm = autoconfirm;
+            Enabled = enabled;
+            Provider = provider;
+            Twilio = twilio;
+        }
+    }
+}
@@ -0,0 +1,63 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Supabase.Outputs
+{
+
+    [OutputType]
+    public sealed class ProjectAuthSmtp
+    {
+        /// <summary>
+        /// Email address the auth emails are sent from
+        /// </summary>
+        public readonly string? AdminEmail;
+        /// <summary>
+        /// Hostname of the SMTP server
+        /// </summary>
+        public readonly string? Host;
+        /// <summary>
+        /// Password of the SMTP server
+        /// </summary>
+        public readonly string? Pass;
+        /// <summary>
+        /// Port of the SMTP server
+        /// </summary>
+        public readonly int? Port;
+        /// <summary>
+        /// Name the auth emails are sent from
+        /// </summary>
+        public readonly string? SenderName;
+        /// <summary>
+        /// Username of the SMTP server
+        /// </summary>
+        public readonly string? User;
+
+        [OutputConstructor]
+        private ProjectAuthSmtp(
+            string? adminEmail,
+
+            string? host,
+
+            string? pass,
+
+            int? port,
+
+            string? senderName,
+
+            string? user)
+        {
+            AdminEmail = adminEmail;
+            Host = host;
+            Pass = pass;
+            Port = port;
+            SenderName = senderName;
+            User = user;
+        }
+    }
+}
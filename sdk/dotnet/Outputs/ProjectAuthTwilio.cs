@@ -0,0 +1,42 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Supabase.Outputs
+{
+
+    [OutputType]
+    public sealed class ProjectAuthTwilio
+    {
+        /// <summary>
+        /// Twilio account SID
+        /// </summary>
+        public readonly string? AccountSid;
+        /// <summary>
+        /// Twilio auth token
+        /// </summary>
+        public readonly string? AuthToken;
+        /// <summary>
+        /// Twilio message service SID
+        /// </summary>
+        public readonly string? MessageServiceSid;
+
+        [OutputConstructor]
+        private ProjectAuthTwilio(
+            string? accountSid,
+
+            string? authToken,
+
+            string? messageServiceSid)
+        {
+            AccountSid = accountSid;
+            AuthToken = authToken;
+            MessageServiceSid = messageServiceSid;
+        }
+    }
+}
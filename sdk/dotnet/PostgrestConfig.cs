@@ -0,0 +1,132 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Supabase
+{
+    /// <summary>
+    /// PostgREST (Data API) configuration of a project. Only the settings given are managed, the others are left as is,
+    /// and deleting the resource restores the settings of a new project.
+    /// 
+    /// The configuration of a project can be imported with its reference:
+    /// `pulumi import supabase:index:PostgrestConfig api &lt;projectRef&gt;`
+    /// </summary>
+    [SupabaseResourceType("supabase:index:PostgrestConfig")]
+    public partial class PostgrestConfig : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Schemas added to the search path of every request (public and extensions by default)
+        /// </summary>
+        [Output("dbExtraSearchPath")]
+        public Output<ImmutableArray<string>> DbExtraSearchPath { get; private set; } = null!;
+
+        /// <summary>
+        /// Schemas exposed by the API (public, storage and graphql_public by default)
+        /// </summary>
+        [Output("dbSchema")]
+        public Output<ImmutableArray<string>> DbSchema { get; private set; } = null!;
+
+        /// <summary>
+        /// Maximum number of rows returned by a request (1000 by default)
+        /// </summary>
+        [Output("maxRows")]
+        public Output<int?> MaxRows { get; private set; } = null!;
+
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        [Output("projectId")]
+        public Output<string> ProjectId { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a PostgrestConfig resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public PostgrestConfig(string name, PostgrestConfigArgs args, CustomResourceOptions? options = null)
+            : base("supabase:index:PostgrestConfig", name, args ?? new PostgrestConfigArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private PostgrestConfig(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("supabase:index:PostgrestConfig", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/LuxChanLu",
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing PostgrestConfig resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static PostgrestConfig Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new PostgrestConfig(name, id, options);
+        }
+    }
+
+    public sealed class PostgrestConfigArgs : Pulumi.ResourceArgs
+    {
+        [Input("dbExtraSearchPath")]
+        private InputList<string>? _dbExtraSearchPath;
+
+        /// <summary>
+        /// Schemas added to the search path of every request (public and extensions by default)
+        /// </summary>
+        public InputList<string> DbExtraSearchPath
+        {
+            get => _dbExtraSearchPath ?? (_dbExtraSearchPath = new InputList<string>());
+            set => _dbExtraSearchPath = value;
+        }
+
+        [Input("dbSchema")]
+        private InputList<string>? _dbSchema;
+
+        /// <summary>
+        /// Schemas exposed by the API (public, storage and graphql_public by default)
+        /// </summary>
+        public InputList<string> DbSchema
+        {
+            get => _dbSchema ?? (_dbSchema = new InputList<string>());
+            set => _dbSchema = value;
+        }
+
+        /// <summary>
+        /// Maximum number of rows returned by a request (1000 by default)
+        /// </summary>
+        [Input("maxRows")]
+        public Input<int>? MaxRows { get; set; }
+
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        [Input("projectId", required: true)]
+        public Input<string> ProjectId { get; set; } = null!;
+
+        public PostgrestConfigArgs()
+        {
+        }
+    }
+}
@@ -9,6 +9,16 @@ using Pulumi.Serialization;
 
 namespace Pulumi.Supabase
 {
+    /// <summary>
+    /// Supabase project.
+    /// 
+    /// Existing projects can be imported with their reference:
+    /// `pulumi import supabase:index:Project my-project &lt;projectRef&gt;`
+    /// The API does not return the database password and KPS setting of a project, and only some versions of it return
+    /// its plan. After an import, the first update records the plan and KPS setting of the program without changing the
+    /// project. A plan change fails the update when the plan read back from the API is not the new one. The password is only
+    /// recorded along with another update, until then changing it does not replace the project.
+    /// </summary>
     [SupabaseResourceType("supabase:index:Project")]
     public partial class Project : Pulumi.CustomResource
     {
@@ -48,12 +58,24 @@ namespace Pulumi.Supabase
         [Output("dbUsername")]
         public Output<string> DbUsername { get; private set; } = null!;
 
+        /// <summary>
+        /// Refuse to delete the project while enabled
+        /// </summary>
+        [Output("deletionProtection")]
+        public Output<bool?> DeletionProtection { get; private set; } = null!;
+
         /// <summary>
         /// Supabase endpoint for client
         /// </summary>
         [Output("endpoint")]
         public Output<string> Endpoint { get; private set; } = null!;
 
+        /// <summary>
+        /// KPS Enabled on the project
+        /// </summary>
+        [Output("kps_enabled")]
+        public Output<bool?> Kps_enabled { get; private set; } = null!;
+
         /// <summary>
         /// Name of the project
         /// </summary>
@@ -66,12 +88,24 @@ namespace Pulumi.Supabase
         [Output("organization_id")]
         public Output<string> Organization_id { get; private set; } = null!;
 
+        /// <summary>
+        /// Plan of the project
+        /// </summary>
+        [Output("plan")]
+        public Output<Pulumi.Supabase.Plan?> Plan { get; private set; } = null!;
+
         /// <summary>
         /// Region of the project
         /// </summary>
         [Output("region")]
         public Output<Pulumi.Supabase.Region> Region { get; private set; } = null!;
 
+        /// <summary>
+        /// Provisioning status of the project (e.g. ACTIVE_HEALTHY)
+        /// </summary>
+        [Output("status")]
+        public Output<string?> Status { get; private set; } = null!;
+
 
         /// <summary>
         /// Create a Project resource with the given unique name, arguments, and options.
@@ -134,6 +168,12 @@ namespace Pulumi.Supabase
             }
         }
 
+        /// <summary>
+        /// Refuse to delete the project while enabled, set to false to allow `pulumi destroy` or replacement
+        /// </summary>
+        [Input("deletionProtection")]
+        public Input<bool>? DeletionProtection { get; set; }
+
         /// <summary>
         /// KPS Enabled on the project
         /// </summary>
@@ -166,6 +206,7 @@ namespace Pulumi.Supabase
 
         public ProjectArgs()
         {
+            DeletionProtection = true;
         }
     }
 }
@@ -0,0 +1,180 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Supabase
+{
+    /// <summary>
+    /// Auth configuration of a project. Only the settings given are managed, the others are left as is,
+    /// and deleting the resource leaves the configuration of the project untouched.
+    /// 
+    /// The configuration of a project can be imported with its reference, every setting is then adopted:
+    /// `pulumi import supabase:index:ProjectAuthConfig auth &lt;projectRef&gt;`
+    /// </summary>
+    [SupabaseResourceType("supabase:index:ProjectAuthConfig")]
+    public partial class ProjectAuthConfig : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Other URLs users may be redirected to after signing in
+        /// </summary>
+        [Output("additionalRedirectUrls")]
+        public Output<ImmutableArray<string>> AdditionalRedirectUrls { get; private set; } = null!;
+
+        /// <summary>
+        /// Refuse the sign up of new users
+        /// </summary>
+        [Output("disableSignup")]
+        public Output<bool?> DisableSignup { get; private set; } = null!;
+
+        /// <summary>
+        /// Email sign in settings
+        /// </summary>
+        [Output("email")]
+        public Output<Outputs.ProjectAuthEmail?> Email { get; private set; } = null!;
+
+        /// <summary>
+        /// OAuth providers by name (apple, azure, github, google, ...)
+        /// </summary>
+        [Output("externalProviders")]
+        public Output<ImmutableDictionary<string, Outputs.ProjectAuthExternalProvider>?> ExternalProviders { get; private set; } = null!;
+
+        /// <summary>
+        /// Lifetime of the access tokens in seconds (up to 604800)
+        /// </summary>
+        [Output("jwtExpiry")]
+        public Output<int?> JwtExpiry { get; private set; } = null!;
+
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        [Output("projectId")]
+        public Output<string> ProjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// URL users are redirected to after signing in when no redirect URL is given
+        /// </summary>
+        [Output("siteUrl")]
+        public Output<string?> SiteUrl { get; private set; } = null!;
+
+        /// <summary>
+        /// Phone sign in settings
+        /// </summary>
+        [Output("sms")]
+        public Output<Outputs.ProjectAuthSms?> Sms { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a ProjectAuthConfig resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public ProjectAuthConfig(string name, ProjectAuthConfigArgs args, CustomResourceOptions? options = null)
+            : base("supabase:index:ProjectAuthConfig", name, args ?? new ProjectAuthConfigArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private ProjectAuthConfig(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("supabase:index:ProjectAuthConfig", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/LuxChanLu",
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing ProjectAuthConfig resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static ProjectAuthConfig Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new ProjectAuthConfig(name, id, options);
+        }
+    }
+
+    public sealed class ProjectAuthConfigArgs : Pulumi.ResourceArgs
+    {
+        [Input("additionalRedirectUrls")]
+        private InputList<string>? _additionalRedirectUrls;
+
+        /// <summary>
+        /// Other URLs users may be redirected to after signing in
+        /// </summary>
+        public InputList<string> AdditionalRedirectUrls
+        {
+            get => _additionalRedirectUrls ?? (_additionalRedirectUrls = new InputList<string>());
+            set => _additionalRedirectUrls = value;
+        }
+
+        /// <summary>
+        /// Refuse the sign up of new users
+        /// </summary>
+        [Input("disableSignup")]
+        public Input<bool>? DisableSignup { get; set; }
+
+        /// <summary>
+        /// Email sign in settings
+        /// </summary>
+        [Input("email")]
+        public Input<Inputs.ProjectAuthEmailArgs>? Email { get; set; }
+
+        [Input("externalProviders")]
+        private InputMap<Inputs.ProjectAuthExternalProviderArgs>? _externalProviders;
+
+        /// <summary>
+        /// OAuth providers by name (apple, azure, github, google, ...)
+        /// </summary>
+        public InputMap<Inputs.ProjectAuthExternalProviderArgs> ExternalProviders
+        {
+            get => _externalProviders ?? (_externalProviders = new InputMap<Inputs.ProjectAuthExternalProviderArgs>());
+            set => _externalProviders = value;
+        }
+
+        /// <summary>
+        /// Lifetime of the access tokens in seconds (up to 604800)
+        /// </summary>
+        [Input("jwtExpiry")]
+        public Input<int>? JwtExpiry { get; set; }
+
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        [Input("projectId", required: true)]
+        public Input<string> ProjectId { get; set; } = null!;
+
+        /// <summary>
+        /// URL users are redirected to after signing in when no redirect URL is given
+        /// </summary>
+        [Input("siteUrl")]
+        public Input<string>? SiteUrl { get; set; }
+
+        /// <summary>
+        /// Phone sign in settings
+        /// </summary>
+        [Input("sms")]
+        public Input<Inputs.ProjectAuthSmsArgs>? Sms { get; set; }
+
+        public ProjectAuthConfigArgs()
+        {
+        }
+    }
+}
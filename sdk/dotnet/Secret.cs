@@ -9,15 +9,33 @@ using Pulumi.Serialization;
 
 namespace Pulumi.Supabase
 {
+    /// <summary>
+    /// Edge function secret of a project.
+    /// 
+    /// Existing secrets can be imported with their project reference and name:
+    /// `pulumi import supabase:index:Secret my-secret &lt;projectRef&gt;/&lt;name&gt;`
+    /// </summary>
     [SupabaseResourceType("supabase:index:Secret")]
     public partial class Secret : Pulumi.CustomResource
     {
+        /// <summary>
+        /// SHA-256 digest of the deployed value, used to detect changes made outside of Pulumi
+        /// </summary>
+        [Output("digest")]
+        public Output<string> Digest { get; private set; } = null!;
+
         /// <summary>
         /// Name of the secret
         /// </summary>
         [Output("name")]
         public Output<string> Name { get; private set; } = null!;
 
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        [Output("projectId")]
+        public Output<string?> ProjectId { get; private set; } = null!;
+
         /// <summary>
         /// Value of the secret
         /// </summary>
@@ -50,6 +68,7 @@ namespace Pulumi.Supabase
                 PluginDownloadURL = "github://api.github.com/LuxChanLu",
                 AdditionalSecretOutputs =
                 {
+                    "digest",
                     "value",
                 },
             };
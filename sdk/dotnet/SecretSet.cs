@@ -0,0 +1,129 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Supabase
+{
+    /// <summary>
+    /// All the edge function secrets of a project managed together, every change is applied in a single API call.
+    /// 
+    /// Existing secrets can be adopted with the project reference: `pulumi import supabase:index:SecretSet secrets &lt;projectRef&gt;`,
+    /// their values are not readable so they are written again on the next update.
+    /// </summary>
+    [SupabaseResourceType("supabase:index:SecretSet")]
+    public partial class SecretSet : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// SHA-256 digest of every deployed value, used to detect changes made outside of Pulumi
+        /// </summary>
+        [Output("digests")]
+        public Output<ImmutableDictionary<string, string>> Digests { get; private set; } = null!;
+
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        [Output("projectId")]
+        public Output<string> ProjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// Delete the secrets of the project missing from `secrets`
+        /// </summary>
+        [Output("prune")]
+        public Output<bool?> Prune { get; private set; } = null!;
+
+        /// <summary>
+        /// Secret values by name
+        /// </summary>
+        [Output("secrets")]
+        public Output<ImmutableDictionary<string, string>> Secrets { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a SecretSet resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public SecretSet(string name, SecretSetArgs args, CustomResourceOptions? options = null)
+            : base("supabase:index:SecretSet", name, args ?? new SecretSetArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private SecretSet(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("supabase:index:SecretSet", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/LuxChanLu",
+                AdditionalSecretOutputs =
+                {
+                    "digests",
+                    "secrets",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing SecretSet resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static SecretSet Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new SecretSet(name, id, options);
+        }
+    }
+
+    public sealed class SecretSetArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        [Input("projectId", required: true)]
+        public Input<string> ProjectId { get; set; } = null!;
+
+        /// <summary>
+        /// Delete the secrets of the project missing from `secrets` (secrets prefixed with SUPABASE_ are kept)
+        /// </summary>
+        [Input("prune")]
+        public Input<bool>? Prune { get; set; }
+
+        [Input("secrets", required: true)]
+        private InputMap<string>? _secrets;
+
+        /// <summary>
+        /// Secret values by name
+        /// </summary>
+        public InputMap<string> Secrets
+        {
+            get => _secrets ?? (_secrets = new InputMap<string>());
+            set
+            {
+                var emptySecret = Output.CreateSecret(ImmutableDictionary.Create<string, string>());
+                _secrets = Output.All(value, emptySecret).Apply(v => v[0]);
+            }
+        }
+
+        public SecretSetArgs()
+        {
+            Prune = false;
+        }
+    }
+}
@@ -0,0 +1,102 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Supabase
+{
+    /// <summary>
+    /// SSL enforcement of the database of a project. Deleting the resource stops enforcing SSL, as on a new project.
+    /// 
+    /// The enforcement of a project can be imported with its reference:
+    /// `pulumi import supabase:index:SslEnforcement ssl &lt;projectRef&gt;`
+    /// </summary>
+    [SupabaseResourceType("supabase:index:SslEnforcement")]
+    public partial class SslEnforcement : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Whether the requested enforcement was applied to the database
+        /// </summary>
+        [Output("appliedSuccessfully")]
+        public Output<bool> AppliedSuccessfully { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether connections to the database must use SSL
+        /// </summary>
+        [Output("database")]
+        public Output<bool> Database { get; private set; } = null!;
+
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        [Output("projectId")]
+        public Output<string> ProjectId { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a SslEnforcement resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public SslEnforcement(string name, SslEnforcementArgs args, CustomResourceOptions? options = null)
+            : base("supabase:index:SslEnforcement", name, args ?? new SslEnforcementArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private SslEnforcement(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("supabase:index:SslEnforcement", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/LuxChanLu",
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing SslEnforcement resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static SslEnforcement Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new SslEnforcement(name, id, options);
+        }
+    }
+
+    public sealed class SslEnforcementArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether connections to the database must use SSL
+        /// </summary>
+        [Input("database")]
+        public Input<bool>? Database { get; set; }
+
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        [Input("projectId", required: true)]
+        public Input<string> ProjectId { get; set; } = null!;
+
+        public SslEnforcementArgs()
+        {
+            Database = true;
+        }
+    }
+}
@@ -0,0 +1,108 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Supabase
+{
+    /// <summary>
+    /// Vanity subdomain of a project. Its availability is checked during the preview, and deleting the resource
+    /// removes the subdomain.
+    /// 
+    /// The vanity subdomain of a project can be imported with its reference:
+    /// `pulumi import supabase:index:VanitySubdomain subdomain &lt;projectRef&gt;`
+    /// </summary>
+    [SupabaseResourceType("supabase:index:VanitySubdomain")]
+    public partial class VanitySubdomain : Pulumi.CustomResource
+    {
+        /// <summary>
+        /// Domain serving the project, &lt;subdomain&gt;.supabase.co
+        /// </summary>
+        [Output("customDomain")]
+        public Output<string> CustomDomain { get; private set; } = null!;
+
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        [Output("projectId")]
+        public Output<string> ProjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// Status of the subdomain (active, custom-domain-used or not-used)
+        /// </summary>
+        [Output("status")]
+        public Output<string> Status { get; private set; } = null!;
+
+        /// <summary>
+        /// Vanity subdomain of the project, served as &lt;subdomain&gt;.supabase.co
+        /// </summary>
+        [Output("subdomain")]
+        public Output<string> Subdomain { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a VanitySubdomain resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public VanitySubdomain(string name, VanitySubdomainArgs args, CustomResourceOptions? options = null)
+            : base("supabase:index:VanitySubdomain", name, args ?? new VanitySubdomainArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private VanitySubdomain(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("supabase:index:VanitySubdomain", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                PluginDownloadURL = "github://api.github.com/LuxChanLu",
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing VanitySubdomain resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static VanitySubdomain Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new VanitySubdomain(name, id, options);
+        }
+    }
+
+    public sealed class VanitySubdomainArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// ID of the project
+        /// </summary>
+        [Input("projectId", required: true)]
+        public Input<string> ProjectId { get; set; } = null!;
+
+        /// <summary>
+        /// Vanity subdomain of the project, served as &lt;subdomain&gt;.supabase.co
+        /// </summary>
+        [Input("subdomain", required: true)]
+        public Input<string> Subdomain { get; set; } = null!;
+
+        public VanitySubdomainArgs()
+        {
+        }
+    }
+}
@@ -8,6 +8,42 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi/config"
 )
 
+// Client side limit of Management API requests per second shared by all resource operations, 0 disables it
+func GetMaxRequestsPerSecond(ctx *pulumi.Context) float64 {
+	v, err := config.TryFloat64(ctx, "supabase:maxRequestsPerSecond")
+	if err == nil {
+		return v
+	}
+	return 1.0
+}
+
+// Maximum number of retries for rate limited (429) or transient Management API failures
+func GetMaxRetries(ctx *pulumi.Context) int {
+	v, err := config.TryInt(ctx, "supabase:maxRetries")
+	if err == nil {
+		return v
+	}
+	return 3
+}
+
+// Initial delay in seconds between retries, doubled on every attempt with jitter
+func GetRetryBaseDelay(ctx *pulumi.Context) float64 {
+	v, err := config.TryFloat64(ctx, "supabase:retryBaseDelay")
+	if err == nil {
+		return v
+	}
+	return 1.0
+}
+
+// Maximum delay in seconds between retries, a longer Retry-After from the API is capped to it
+func GetRetryMaxDelay(ctx *pulumi.Context) float64 {
+	v, err := config.TryFloat64(ctx, "supabase:retryMaxDelay")
+	if err == nil {
+		return v
+	}
+	return 30.0
+}
+
 // Supabase server (https://api.supabase.com/)
 func GetServer(ctx *pulumi.Context) string {
 	v, err := config.Try(ctx, "supabase:server")
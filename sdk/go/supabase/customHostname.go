@@ -0,0 +1,237 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package supabase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Custom hostname of a project. The `dnsRecords` output lists the CNAME and TXT records to create at the DNS provider
+// of the hostname. Once they exist, set `waitForVerification` to wait for the hostname to be verified and activate it.
+// Do not set it on creation when the DNS records depend on this resource, the verification would never succeed.
+// Deleting the resource removes the custom hostname.
+//
+// The custom hostname of a project can be imported with its reference:
+// `pulumi import supabase:index:CustomHostname api <projectRef>`
+type CustomHostname struct {
+	pulumi.CustomResourceState
+
+	// Whether the hostname is verified and serves the project
+	Activated pulumi.BoolOutput `pulumi:"activated"`
+	// DNS records to create for the hostname to be verified
+	DnsRecords DnsRecordArrayOutput `pulumi:"dnsRecords"`
+	// Custom hostname of the project, such as api.example.com
+	Hostname pulumi.StringOutput `pulumi:"hostname"`
+	// ID of the project
+	ProjectId pulumi.StringOutput `pulumi:"projectId"`
+	// Verification status of the hostname (1_not_started to 5_services_reconfigured)
+	Status pulumi.StringOutput `pulumi:"status"`
+}
+
+// NewCustomHostname registers a new resource with the given unique name, arguments, and options.
+func NewCustomHostname(ctx *pulumi.Context,
+	name string, args *CustomHostnameArgs, opts ...pulumi.ResourceOption) (*CustomHostname, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Hostname == nil {
+		return nil, errors.New("invalid value for required argument 'Hostname'")
+	}
+	if args.ProjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ProjectId'")
+	}
+	if isZero(args.WaitForVerification) {
+		args.WaitForVerification = pulumi.BoolPtr(false)
+	}
+	opts = pkgResourceDefaultOpts(opts)
+	var resource CustomHostname
+	err := ctx.RegisterResource("supabase:index:CustomHostname", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetCustomHostname gets an existing CustomHostname resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetCustomHostname(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *CustomHostnameState, opts ...pulumi.ResourceOption) (*CustomHostname, error) {
+	var resource CustomHostname
+	err := ctx.ReadResource("supabase:index:CustomHostname", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering CustomHostname resources.
+type customHostnameState struct {
+}
+
+type CustomHostnameState struct {
+}
+
+func (CustomHostnameState) ElementType() reflect.Type {
+	return reflect.TypeOf((*customHostnameState)(nil)).Elem()
+}
+
+type customHostnameArgs struct {
+	// Custom hostname of the project, such as api.example.com
+	Hostname string `pulumi:"hostname"`
+	// ID of the project
+	ProjectId string `pulumi:"projectId"`
+	// Whether to wait for the DNS records to be verified and then activate the hostname
+	WaitForVerification *bool `pulumi:"waitForVerification"`
+}
+
+// The set of arguments for constructing a CustomHostname resource.
+type CustomHostnameArgs struct {
+	// Custom hostname of the project, such as api.example.com
+	Hostname pulumi.StringInput
+	// ID of the project
+	ProjectId pulumi.StringInput
+	// Whether to wait for the DNS records to be verified and then activate the hostname
+	WaitForVerification pulumi.BoolPtrInput
+}
+
+func (CustomHostnameArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*customHostnameArgs)(nil)).Elem()
+}
+
+type CustomHostnameInput interface {
+	pulumi.Input
+
+	ToCustomHostnameOutput() CustomHostnameOutput
+	ToCustomHostnameOutputWithContext(ctx context.Context) CustomHostnameOutput
+}
+
+func (*CustomHostname) ElementType() reflect.Type {
+	return reflect.TypeOf((**CustomHostname)(nil)).Elem()
+}
+
+func (i *CustomHostname) ToCustomHostnameOutput() CustomHostnameOutput {
+	return i.ToCustomHostnameOutputWithContext(context.Background())
+}
+
+func (i *CustomHostname) ToCustomHostnameOutputWithContext(ctx context.Context) CustomHostnameOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CustomHostnameOutput)
+}
+
+// CustomHostnameArrayInput is an input type that accepts CustomHostnameArray and CustomHostnameArrayOutput values.
+// You can construct a concrete instance of `CustomHostnameArrayInput` via:
+//
+//	CustomHostnameArray{ CustomHostnameArgs{...} }
+type CustomHostnameArrayInput interface {
+	pulumi.Input
+
+	ToCustomHostnameArrayOutput() CustomHostnameArrayOutput
+	ToCustomHostnameArrayOutputWithContext(context.Context) CustomHostnameArrayOutput
+}
+
+type CustomHostnameArray []CustomHostnameInput
+
+func (CustomHostnameArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*CustomHostname)(nil)).Elem()
+}
+
+func (i CustomHostnameArray) ToCustomHostnameArrayOutput() CustomHostnameArrayOutput {
+	return i.ToCustomHostnameArrayOutputWithContext(context.Background())
+}
+
+func (i CustomHostnameArray) ToCustomHostnameArrayOutputWithContext(ctx context.Context) CustomHostnameArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CustomHostnameArrayOutput)
+}
+
+// CustomHostnameMapInput is an input type that accepts CustomHostnameMap and CustomHostnameMapOutput values.
+// You can construct a concrete instance of `CustomHostnameMapInput` via:
+//
+//	CustomHostnameMap{ "key": CustomHostnameArgs{...} }
+type CustomHostnameMapInput interface {
+	pulumi.Input
+
+	ToCustomHostnameMapOutput() CustomHostnameMapOutput
+	ToCustomHostnameMapOutputWithContext(context.Context) CustomHostnameMapOutput
+}
+
+type CustomHostnameMap map[string]CustomHostnameInput
+
+func (CustomHostnameMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*CustomHostname)(nil)).Elem()
+}
+
+func (i CustomHostnameMap) ToCustomHostnameMapOutput() CustomHostnameMapOutput {
+	return i.ToCustomHostnameMapOutputWithContext(context.Background())
+}
+
+func (i CustomHostnameMap) ToCustomHostnameMapOutputWithContext(ctx context.Context) CustomHostnameMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CustomHostnameMapOutput)
+}
+
+type CustomHostnameOutput struct{ *pulumi.OutputState }
+
+func (CustomHostnameOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**CustomHostname)(nil)).Elem()
+}
+
+func (o CustomHostnameOutput) ToCustomHostnameOutput() CustomHostnameOutput {
+	return o
+}
+
+func (o CustomHostnameOutput) ToCustomHostnameOutputWithContext(ctx context.Context) CustomHostnameOutput {
+	return o
+}
+
+type CustomHostnameArrayOutput struct{ *pulumi.OutputState }
+
+func (CustomHostnameArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*CustomHostname)(nil)).Elem()
+}
+
+func (o CustomHostnameArrayOutput) ToCustomHostnameArrayOutput() CustomHostnameArrayOutput {
+	return o
+}
+
+func (o CustomHostnameArrayOutput) ToCustomHostnameArrayOutputWithContext(ctx context.Context) CustomHostnameArrayOutput {
+	return o
+}
+
+func (o CustomHostnameArrayOutput) Index(i pulumi.IntInput) CustomHostnameOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *CustomHostname {
+		return vs[0].([]*CustomHostname)[vs[1].(int)]
+	}).(CustomHostnameOutput)
+}
+
+type CustomHostnameMapOutput struct{ *pulumi.OutputState }
+
+func (CustomHostnameMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*CustomHostname)(nil)).Elem()
+}
+
+func (o CustomHostnameMapOutput) ToCustomHostnameMapOutput() CustomHostnameMapOutput {
+	return o
+}
+
+func (o CustomHostnameMapOutput) ToCustomHostnameMapOutputWithContext(ctx context.Context) CustomHostnameMapOutput {
+	return o
+}
+
+func (o CustomHostnameMapOutput) MapIndex(k pulumi.StringInput) CustomHostnameOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *CustomHostname {
+		return vs[0].(map[string]*CustomHostname)[vs[1].(string)]
+	}).(CustomHostnameOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*CustomHostnameInput)(nil)).Elem(), &CustomHostname{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CustomHostnameArrayInput)(nil)).Elem(), CustomHostnameArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CustomHostnameMapInput)(nil)).Elem(), CustomHostnameMap{})
+	pulumi.RegisterOutputType(CustomHostnameOutput{})
+	pulumi.RegisterOutputType(CustomHostnameArrayOutput{})
+	pulumi.RegisterOutputType(CustomHostnameMapOutput{})
+}
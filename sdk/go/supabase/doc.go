@@ -1,3 +1,2 @@
 // Package supabase exports types, functions, subpackages for provisioning supabase resources.
-//
 package supabase
@@ -11,15 +11,33 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
+// Edge function of a project.
+//
+// Existing functions can be imported with their project reference and slug:
+// `pulumi import supabase:index:Function my-function <projectRef>/<slug>`
 type Function struct {
 	pulumi.CustomResourceState
 
+	// SHA-256 of the deployed body, stored in place of the body itself
+	BodyHash pulumi.StringPtrOutput `pulumi:"bodyHash"`
 	// Function creation date
 	Created_at pulumi.StringOutput `pulumi:"created_at"`
+	// Entrypoint of the function
+	EntrypointPath pulumi.StringPtrOutput `pulumi:"entrypointPath"`
+	// Whether the function is deployed with an import map
+	HasImportMap pulumi.BoolPtrOutput `pulumi:"hasImportMap"`
+	// Import map of the function, inline or as the path to its file
+	ImportMap pulumi.StringPtrOutput `pulumi:"importMap"`
+	// Path the import map is deployed to
+	ImportMapPath pulumi.StringPtrOutput `pulumi:"importMapPath"`
 	// Name of the function
 	Name pulumi.StringOutput `pulumi:"name"`
+	// ID of the project
+	ProjectId pulumi.StringPtrOutput `pulumi:"projectId"`
 	// Slug of the function
 	Slug pulumi.StringOutput `pulumi:"slug"`
+	// SHA-256 of the deployed source bundle
+	SourceHash pulumi.StringPtrOutput `pulumi:"sourceHash"`
 	// Status of the function
 	Status FunctionStatusOutput `pulumi:"status"`
 	// Function updated date
@@ -37,9 +55,6 @@ func NewFunction(ctx *pulumi.Context,
 		return nil, errors.New("missing one or more required arguments")
 	}
 
-	if args.Body == nil {
-		return nil, errors.New("invalid value for required argument 'Body'")
-	}
 	if args.Name == nil {
 		return nil, errors.New("invalid value for required argument 'Name'")
 	}
@@ -53,7 +68,7 @@ func NewFunction(ctx *pulumi.Context,
 		args.Verify_jwt = pulumi.BoolPtr(false)
 	}
 	if args.Body != nil {
-		args.Body = pulumi.ToSecret(args.Body).(pulumi.StringOutput)
+		args.Body = pulumi.ToSecret(args.Body).(pulumi.StringPtrOutput)
 	}
 	opts = pkgResourceDefaultOpts(opts)
 	var resource Function
@@ -89,13 +104,21 @@ func (FunctionState) ElementType() reflect.Type {
 
 type functionArgs struct {
 	// Body of the functino
-	Body string `pulumi:"body"`
+	Body *string `pulumi:"body"`
+	// Entrypoint of the function relative to its source directory (index.ts by default), or the file name body is deployed as
+	EntrypointPath *string `pulumi:"entrypointPath"`
+	// Import map of the function, given inline as JSON or as the path to its file. It replaces the import_map.json or deno.json found next to the entrypoint
+	ImportMap *string `pulumi:"importMap"`
+	// Path the import map is deployed to, relative to the entrypoint directory. Its relative mappings are rewritten to point to the same modules. By default an import map file keeps its place relative to the modules
+	ImportMapPath *string `pulumi:"importMapPath"`
 	// Name of the function
 	Name string `pulumi:"name"`
 	// ID of the project
 	ProjectId string `pulumi:"projectId"`
 	// Slug of the function
 	Slug string `pulumi:"slug"`
+	// Local directory (with an index.ts entrypoint) or entrypoint file of the function, its local imports and import map are bundled and deployed in place of body
+	Source *string `pulumi:"source"`
 	// Verify JWT before running
 	Verify_jwt *bool `pulumi:"verify_jwt"`
 }
@@ -103,13 +126,21 @@ type functionArgs struct {
 // The set of arguments for constructing a Function resource.
 type FunctionArgs struct {
 	// Body of the functino
-	Body pulumi.StringInput
+	Body pulumi.StringPtrInput
+	// Entrypoint of the function relative to its source directory (index.ts by default), or the file name body is deployed as
+	EntrypointPath pulumi.StringPtrInput
+	// Import map of the function, given inline as JSON or as the path to its file. It replaces the import_map.json or deno.json found next to the entrypoint
+	ImportMap pulumi.StringPtrInput
+	// Path the import map is deployed to, relative to the entrypoint directory. Its relative mappings are rewritten to point to the same modules. By default an import map file keeps its place relative to the modules
+	ImportMapPath pulumi.StringPtrInput
 	// Name of the function
 	Name pulumi.StringInput
 	// ID of the project
 	ProjectId pulumi.StringInput
 	// Slug of the function
 	Slug pulumi.StringInput
+	// Local directory (with an index.ts entrypoint) or entrypoint file of the function, its local imports and import map are bundled and deployed in place of body
+	Source pulumi.StringPtrInput
 	// Verify JWT before running
 	Verify_jwt pulumi.BoolPtrInput
 }
@@ -140,7 +171,7 @@ func (i *Function) ToFunctionOutputWithContext(ctx context.Context) FunctionOutp
 // FunctionArrayInput is an input type that accepts FunctionArray and FunctionArrayOutput values.
 // You can construct a concrete instance of `FunctionArrayInput` via:
 //
-//          FunctionArray{ FunctionArgs{...} }
+//	FunctionArray{ FunctionArgs{...} }
 type FunctionArrayInput interface {
 	pulumi.Input
 
@@ -165,7 +196,7 @@ func (i FunctionArray) ToFunctionArrayOutputWithContext(ctx context.Context) Fun
 // FunctionMapInput is an input type that accepts FunctionMap and FunctionMapOutput values.
 // You can construct a concrete instance of `FunctionMapInput` via:
 //
-//          FunctionMap{ "key": FunctionArgs{...} }
+//	FunctionMap{ "key": FunctionArgs{...} }
 type FunctionMapInput interface {
 	pulumi.Input
 
@@ -0,0 +1,84 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package supabase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Lists the IPv4 addresses banned by the database of a project
+func GetNetworkBans(ctx *pulumi.Context, args *GetNetworkBansArgs, opts ...pulumi.InvokeOption) (*GetNetworkBansResult, error) {
+	opts = pkgInvokeDefaultOpts(opts)
+	var rv GetNetworkBansResult
+	err := ctx.Invoke("supabase:index:getNetworkBans", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetNetworkBansArgs struct {
+	// ID of the project
+	ProjectId string `pulumi:"projectId"`
+}
+
+type GetNetworkBansResult struct {
+	// Banned IPv4 addresses
+	BannedIpv4Addresses []string `pulumi:"bannedIpv4Addresses"`
+	// ID of the project
+	ProjectId string `pulumi:"projectId"`
+}
+
+func GetNetworkBansOutput(ctx *pulumi.Context, args GetNetworkBansOutputArgs, opts ...pulumi.InvokeOption) GetNetworkBansResultOutput {
+	return pulumi.ToOutputWithContext(context.Background(), args).
+		ApplyT(func(v interface{}) (GetNetworkBansResult, error) {
+			args := v.(GetNetworkBansArgs)
+			r, err := GetNetworkBans(ctx, &args, opts...)
+			var s GetNetworkBansResult
+			if r != nil {
+				s = *r
+			}
+			return s, err
+		}).(GetNetworkBansResultOutput)
+}
+
+type GetNetworkBansOutputArgs struct {
+	// ID of the project
+	ProjectId pulumi.StringInput `pulumi:"projectId"`
+}
+
+func (GetNetworkBansOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetNetworkBansArgs)(nil)).Elem()
+}
+
+type GetNetworkBansResultOutput struct{ *pulumi.OutputState }
+
+func (GetNetworkBansResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetNetworkBansResult)(nil)).Elem()
+}
+
+func (o GetNetworkBansResultOutput) ToGetNetworkBansResultOutput() GetNetworkBansResultOutput {
+	return o
+}
+
+func (o GetNetworkBansResultOutput) ToGetNetworkBansResultOutputWithContext(ctx context.Context) GetNetworkBansResultOutput {
+	return o
+}
+
+// Banned IPv4 addresses
+func (o GetNetworkBansResultOutput) BannedIpv4Addresses() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v GetNetworkBansResult) []string { return v.BannedIpv4Addresses }).(pulumi.StringArrayOutput)
+}
+
+// ID of the project
+func (o GetNetworkBansResultOutput) ProjectId() pulumi.StringOutput {
+	return o.ApplyT(func(v GetNetworkBansResult) string { return v.ProjectId }).(pulumi.StringOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetNetworkBansResultOutput{})
+}
@@ -20,14 +20,30 @@ func (m *module) Version() semver.Version {
 
 func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi.Resource, err error) {
 	switch typ {
+	case "supabase:index:CustomHostname":
+		r = &CustomHostname{}
 	case "supabase:index:Function":
 		r = &Function{}
+	case "supabase:index:NetworkBanRemoval":
+		r = &NetworkBanRemoval{}
+	case "supabase:index:NetworkRestrictions":
+		r = &NetworkRestrictions{}
 	case "supabase:index:Organization":
 		r = &Organization{}
+	case "supabase:index:PostgrestConfig":
+		r = &PostgrestConfig{}
 	case "supabase:index:Project":
 		r = &Project{}
+	case "supabase:index:ProjectAuthConfig":
+		r = &ProjectAuthConfig{}
 	case "supabase:index:Secret":
 		r = &Secret{}
+	case "supabase:index:SecretSet":
+		r = &SecretSet{}
+	case "supabase:index:SslEnforcement":
+		r = &SslEnforcement{}
+	case "supabase:index:VanitySubdomain":
+		r = &VanitySubdomain{}
 	default:
 		return nil, fmt.Errorf("unknown resource type: %s", typ)
 	}
@@ -0,0 +1,228 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package supabase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Lifts the network bans of a set of IPv4 addresses of a project, the database bans addresses after repeated
+// failed connections. The bans are lifted on creation and again whenever the addresses or the triggers change,
+// deleting the resource does not ban the addresses again.
+type NetworkBanRemoval struct {
+	pulumi.CustomResourceState
+
+	// IPv4 addresses whose bans are lifted
+	Ipv4Addresses pulumi.StringArrayOutput `pulumi:"ipv4Addresses"`
+	// ID of the project
+	ProjectId pulumi.StringOutput `pulumi:"projectId"`
+	// Values which lift the bans again whenever they change, such as a deployment ID
+	Triggers pulumi.ArrayOutput `pulumi:"triggers"`
+	// Addresses which were banned when the bans were last lifted
+	UnbannedIpv4Addresses pulumi.StringArrayOutput `pulumi:"unbannedIpv4Addresses"`
+}
+
+// NewNetworkBanRemoval registers a new resource with the given unique name, arguments, and options.
+func NewNetworkBanRemoval(ctx *pulumi.Context,
+	name string, args *NetworkBanRemovalArgs, opts ...pulumi.ResourceOption) (*NetworkBanRemoval, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Ipv4Addresses == nil {
+		return nil, errors.New("invalid value for required argument 'Ipv4Addresses'")
+	}
+	if args.ProjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ProjectId'")
+	}
+	opts = pkgResourceDefaultOpts(opts)
+	var resource NetworkBanRemoval
+	err := ctx.RegisterResource("supabase:index:NetworkBanRemoval", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetNetworkBanRemoval gets an existing NetworkBanRemoval resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetNetworkBanRemoval(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *NetworkBanRemovalState, opts ...pulumi.ResourceOption) (*NetworkBanRemoval, error) {
+	var resource NetworkBanRemoval
+	err := ctx.ReadResource("supabase:index:NetworkBanRemoval", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering NetworkBanRemoval resources.
+type networkBanRemovalState struct {
+}
+
+type NetworkBanRemovalState struct {
+}
+
+func (NetworkBanRemovalState) ElementType() reflect.Type {
+	return reflect.TypeOf((*networkBanRemovalState)(nil)).Elem()
+}
+
+type networkBanRemovalArgs struct {
+	// IPv4 addresses whose bans are lifted
+	Ipv4Addresses []string `pulumi:"ipv4Addresses"`
+	// ID of the project
+	ProjectId string `pulumi:"projectId"`
+	// Values which lift the bans again whenever they change, such as a deployment ID
+	Triggers []interface{} `pulumi:"triggers"`
+}
+
+// The set of arguments for constructing a NetworkBanRemoval resource.
+type NetworkBanRemovalArgs struct {
+	// IPv4 addresses whose bans are lifted
+	Ipv4Addresses pulumi.StringArrayInput
+	// ID of the project
+	ProjectId pulumi.StringInput
+	// Values which lift the bans again whenever they change, such as a deployment ID
+	Triggers pulumi.ArrayInput
+}
+
+func (NetworkBanRemovalArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*networkBanRemovalArgs)(nil)).Elem()
+}
+
+type NetworkBanRemovalInput interface {
+	pulumi.Input
+
+	ToNetworkBanRemovalOutput() NetworkBanRemovalOutput
+	ToNetworkBanRemovalOutputWithContext(ctx context.Context) NetworkBanRemovalOutput
+}
+
+func (*NetworkBanRemoval) ElementType() reflect.Type {
+	return reflect.TypeOf((**NetworkBanRemoval)(nil)).Elem()
+}
+
+func (i *NetworkBanRemoval) ToNetworkBanRemovalOutput() NetworkBanRemovalOutput {
+	return i.ToNetworkBanRemovalOutputWithContext(context.Background())
+}
+
+func (i *NetworkBanRemoval) ToNetworkBanRemovalOutputWithContext(ctx context.Context) NetworkBanRemovalOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NetworkBanRemovalOutput)
+}
+
+// NetworkBanRemovalArrayInput is an input type that accepts NetworkBanRemovalArray and NetworkBanRemovalArrayOutput values.
+// You can construct a concrete instance of `NetworkBanRemovalArrayInput` via:
+//
+//	NetworkBanRemovalArray{ NetworkBanRemovalArgs{...} }
+type NetworkBanRemovalArrayInput interface {
+	pulumi.Input
+
+	ToNetworkBanRemovalArrayOutput() NetworkBanRemovalArrayOutput
+	ToNetworkBanRemovalArrayOutputWithContext(context.Context) NetworkBanRemovalArrayOutput
+}
+
+type NetworkBanRemovalArray []NetworkBanRemovalInput
+
+func (NetworkBanRemovalArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*NetworkBanRemoval)(nil)).Elem()
+}
+
+func (i NetworkBanRemovalArray) ToNetworkBanRemovalArrayOutput() NetworkBanRemovalArrayOutput {
+	return i.ToNetworkBanRemovalArrayOutputWithContext(context.Background())
+}
+
+func (i NetworkBanRemovalArray) ToNetworkBanRemovalArrayOutputWithContext(ctx context.Context) NetworkBanRemovalArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NetworkBanRemovalArrayOutput)
+}
+
+// NetworkBanRemovalMapInput is an input type that accepts NetworkBanRemovalMap and NetworkBanRemovalMapOutput values.
+// You can construct a concrete instance of `NetworkBanRemovalMapInput` via:
+//
+//	NetworkBanRemovalMap{ "key": NetworkBanRemovalArgs{...} }
+type NetworkBanRemovalMapInput interface {
+	pulumi.Input
+
+	ToNetworkBanRemovalMapOutput() NetworkBanRemovalMapOutput
+	ToNetworkBanRemovalMapOutputWithContext(context.Context) NetworkBanRemovalMapOutput
+}
+
+type NetworkBanRemovalMap map[string]NetworkBanRemovalInput
+
+func (NetworkBanRemovalMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*NetworkBanRemoval)(nil)).Elem()
+}
+
+func (i NetworkBanRemovalMap) ToNetworkBanRemovalMapOutput() NetworkBanRemovalMapOutput {
+	return i.ToNetworkBanRemovalMapOutputWithContext(context.Background())
+}
+
+func (i NetworkBanRemovalMap) ToNetworkBanRemovalMapOutputWithContext(ctx context.Context) NetworkBanRemovalMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NetworkBanRemovalMapOutput)
+}
+
+type NetworkBanRemovalOutput struct{ *pulumi.OutputState }
+
+func (NetworkBanRemovalOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**NetworkBanRemoval)(nil)).Elem()
+}
+
+func (o NetworkBanRemovalOutput) ToNetworkBanRemovalOutput() NetworkBanRemovalOutput {
+	return o
+}
+
+func (o NetworkBanRemovalOutput) ToNetworkBanRemovalOutputWithContext(ctx context.Context) NetworkBanRemovalOutput {
+	return o
+}
+
+type NetworkBanRemovalArrayOutput struct{ *pulumi.OutputState }
+
+func (NetworkBanRemovalArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*NetworkBanRemoval)(nil)).Elem()
+}
+
+func (o NetworkBanRemovalArrayOutput) ToNetworkBanRemovalArrayOutput() NetworkBanRemovalArrayOutput {
+	return o
+}
+
+func (o NetworkBanRemovalArrayOutput) ToNetworkBanRemovalArrayOutputWithContext(ctx context.Context) NetworkBanRemovalArrayOutput {
+	return o
+}
+
+func (o NetworkBanRemovalArrayOutput) Index(i pulumi.IntInput) NetworkBanRemovalOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *NetworkBanRemoval {
+		return vs[0].([]*NetworkBanRemoval)[vs[1].(int)]
+	}).(NetworkBanRemovalOutput)
+}
+
+type NetworkBanRemovalMapOutput struct{ *pulumi.OutputState }
+
+func (NetworkBanRemovalMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*NetworkBanRemoval)(nil)).Elem()
+}
+
+func (o NetworkBanRemovalMapOutput) ToNetworkBanRemovalMapOutput() NetworkBanRemovalMapOutput {
+	return o
+}
+
+func (o NetworkBanRemovalMapOutput) ToNetworkBanRemovalMapOutputWithContext(ctx context.Context) NetworkBanRemovalMapOutput {
+	return o
+}
+
+func (o NetworkBanRemovalMapOutput) MapIndex(k pulumi.StringInput) NetworkBanRemovalOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *NetworkBanRemoval {
+		return vs[0].(map[string]*NetworkBanRemoval)[vs[1].(string)]
+	}).(NetworkBanRemovalOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*NetworkBanRemovalInput)(nil)).Elem(), &NetworkBanRemoval{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NetworkBanRemovalArrayInput)(nil)).Elem(), NetworkBanRemovalArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NetworkBanRemovalMapInput)(nil)).Elem(), NetworkBanRemovalMap{})
+	pulumi.RegisterOutputType(NetworkBanRemovalOutput{})
+	pulumi.RegisterOutputType(NetworkBanRemovalArrayOutput{})
+	pulumi.RegisterOutputType(NetworkBanRemovalMapOutput{})
+}
@@ -0,0 +1,225 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package supabase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Network restrictions of the database of a project. Deleting the resource allows every address again.
+//
+// The restrictions of a project can be imported with its reference:
+// `pulumi import supabase:index:NetworkRestrictions db <projectRef>`
+type NetworkRestrictions struct {
+	pulumi.CustomResourceState
+
+	// IPv4 and IPv6 CIDRs allowed to connect to the database, they must not overlap
+	DbAllowedCidrs pulumi.StringArrayOutput `pulumi:"dbAllowedCidrs"`
+	// Whether the plan of the project allows network restrictions (allowed or disallowed)
+	Entitlement pulumi.StringOutput `pulumi:"entitlement"`
+	// ID of the project
+	ProjectId pulumi.StringOutput `pulumi:"projectId"`
+	// Whether the restrictions are applied or only stored
+	Status pulumi.StringOutput `pulumi:"status"`
+}
+
+// NewNetworkRestrictions registers a new resource with the given unique name, arguments, and options.
+func NewNetworkRestrictions(ctx *pulumi.Context,
+	name string, args *NetworkRestrictionsArgs, opts ...pulumi.ResourceOption) (*NetworkRestrictions, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.DbAllowedCidrs == nil {
+		return nil, errors.New("invalid value for required argument 'DbAllowedCidrs'")
+	}
+	if args.ProjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ProjectId'")
+	}
+	opts = pkgResourceDefaultOpts(opts)
+	var resource NetworkRestrictions
+	err := ctx.RegisterResource("supabase:index:NetworkRestrictions", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetNetworkRestrictions gets an existing NetworkRestrictions resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetNetworkRestrictions(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *NetworkRestrictionsState, opts ...pulumi.ResourceOption) (*NetworkRestrictions, error) {
+	var resource NetworkRestrictions
+	err := ctx.ReadResource("supabase:index:NetworkRestrictions", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering NetworkRestrictions resources.
+type networkRestrictionsState struct {
+}
+
+type NetworkRestrictionsState struct {
+}
+
+func (NetworkRestrictionsState) ElementType() reflect.Type {
+	return reflect.TypeOf((*networkRestrictionsState)(nil)).Elem()
+}
+
+type networkRestrictionsArgs struct {
+	// IPv4 and IPv6 CIDRs allowed to connect to the database, they must not overlap
+	DbAllowedCidrs []string `pulumi:"dbAllowedCidrs"`
+	// ID of the project
+	ProjectId string `pulumi:"projectId"`
+}
+
+// The set of arguments for constructing a NetworkRestrictions resource.
+type NetworkRestrictionsArgs struct {
+	// IPv4 and IPv6 CIDRs allowed to connect to the database, they must not overlap
+	DbAllowedCidrs pulumi.StringArrayInput
+	// ID of the project
+	ProjectId pulumi.StringInput
+}
+
+func (NetworkRestrictionsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*networkRestrictionsArgs)(nil)).Elem()
+}
+
+type NetworkRestrictionsInput interface {
+	pulumi.Input
+
+	ToNetworkRestrictionsOutput() NetworkRestrictionsOutput
+	ToNetworkRestrictionsOutputWithContext(ctx context.Context) NetworkRestrictionsOutput
+}
+
+func (*NetworkRestrictions) ElementType() reflect.Type {
+	return reflect.TypeOf((**NetworkRestrictions)(nil)).Elem()
+}
+
+func (i *NetworkRestrictions) ToNetworkRestrictionsOutput() NetworkRestrictionsOutput {
+	return i.ToNetworkRestrictionsOutputWithContext(context.Background())
+}
+
+func (i *NetworkRestrictions) ToNetworkRestrictionsOutputWithContext(ctx context.Context) NetworkRestrictionsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NetworkRestrictionsOutput)
+}
+
+// NetworkRestrictionsArrayInput is an input type that accepts NetworkRestrictionsArray and NetworkRestrictionsArrayOutput values.
+// You can construct a concrete instance of `NetworkRestrictionsArrayInput` via:
+//
+//	NetworkRestrictionsArray{ NetworkRestrictionsArgs{...} }
+type NetworkRestrictionsArrayInput interface {
+	pulumi.Input
+
+	ToNetworkRestrictionsArrayOutput() NetworkRestrictionsArrayOutput
+	ToNetworkRestrictionsArrayOutputWithContext(context.Context) NetworkRestrictionsArrayOutput
+}
+
+type NetworkRestrictionsArray []NetworkRestrictionsInput
+
+func (NetworkRestrictionsArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*NetworkRestrictions)(nil)).Elem()
+}
+
+func (i NetworkRestrictionsArray) ToNetworkRestrictionsArrayOutput() NetworkRestrictionsArrayOutput {
+	return i.ToNetworkRestrictionsArrayOutputWithContext(context.Background())
+}
+
+func (i NetworkRestrictionsArray) ToNetworkRestrictionsArrayOutputWithContext(ctx context.Context) NetworkRestrictionsArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NetworkRestrictionsArrayOutput)
+}
+
+// NetworkRestrictionsMapInput is an input type that accepts NetworkRestrictionsMap and NetworkRestrictionsMapOutput values.
+// You can construct a concrete instance of `NetworkRestrictionsMapInput` via:
+//
+//	NetworkRestrictionsMap{ "key": NetworkRestrictionsArgs{...} }
+type NetworkRestrictionsMapInput interface {
+	pulumi.Input
+
+	ToNetworkRestrictionsMapOutput() NetworkRestrictionsMapOutput
+	ToNetworkRestrictionsMapOutputWithContext(context.Context) NetworkRestrictionsMapOutput
+}
+
+type NetworkRestrictionsMap map[string]NetworkRestrictionsInput
+
+func (NetworkRestrictionsMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*NetworkRestrictions)(nil)).Elem()
+}
+
+func (i NetworkRestrictionsMap) ToNetworkRestrictionsMapOutput() NetworkRestrictionsMapOutput {
+	return i.ToNetworkRestrictionsMapOutputWithContext(context.Background())
+}
+
+func (i NetworkRestrictionsMap) ToNetworkRestrictionsMapOutputWithContext(ctx context.Context) NetworkRestrictionsMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NetworkRestrictionsMapOutput)
+}
+
+type NetworkRestrictionsOutput struct{ *pulumi.OutputState }
+
+func (NetworkRestrictionsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**NetworkRestrictions)(nil)).Elem()
+}
+
+func (o NetworkRestrictionsOutput) ToNetworkRestrictionsOutput() NetworkRestrictionsOutput {
+	return o
+}
+
+func (o NetworkRestrictionsOutput) ToNetworkRestrictionsOutputWithContext(ctx context.Context) NetworkRestrictionsOutput {
+	return o
+}
+
+type NetworkRestrictionsArrayOutput struct{ *pulumi.OutputState }
+
+func (NetworkRestrictionsArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*NetworkRestrictions)(nil)).Elem()
+}
+
+func (o NetworkRestrictionsArrayOutput) ToNetworkRestrictionsArrayOutput() NetworkRestrictionsArrayOutput {
+	return o
+}
+
+func (o NetworkRestrictionsArrayOutput) ToNetworkRestrictionsArrayOutputWithContext(ctx context.Context) NetworkRestrictionsArrayOutput {
+	return o
+}
+
+func (o NetworkRestrictionsArrayOutput) Index(i pulumi.IntInput) NetworkRestrictionsOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *NetworkRestrictions {
+		return vs[0].([]*NetworkRestrictions)[vs[1].(int)]
+	}).(NetworkRestrictionsOutput)
+}
+
+type NetworkRestrictionsMapOutput struct{ *pulumi.OutputState }
+
+func (NetworkRestrictionsMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*NetworkRestrictions)(nil)).Elem()
+}
+
+func (o NetworkRestrictionsMapOutput) ToNetworkRestrictionsMapOutput() NetworkRestrictionsMapOutput {
+	return o
+}
+
+func (o NetworkRestrictionsMapOutput) ToNetworkRestrictionsMapOutputWithContext(ctx context.Context) NetworkRestrictionsMapOutput {
+	return o
+}
+
+func (o NetworkRestrictionsMapOutput) MapIndex(k pulumi.StringInput) NetworkRestrictionsOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *NetworkRestrictions {
+		return vs[0].(map[string]*NetworkRestrictions)[vs[1].(string)]
+	}).(NetworkRestrictionsOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*NetworkRestrictionsInput)(nil)).Elem(), &NetworkRestrictions{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NetworkRestrictionsArrayInput)(nil)).Elem(), NetworkRestrictionsArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NetworkRestrictionsMapInput)(nil)).Elem(), NetworkRestrictionsMap{})
+	pulumi.RegisterOutputType(NetworkRestrictionsOutput{})
+	pulumi.RegisterOutputType(NetworkRestrictionsArrayOutput{})
+	pulumi.RegisterOutputType(NetworkRestrictionsMapOutput{})
+}
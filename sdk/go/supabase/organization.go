@@ -97,7 +97,7 @@ func (i *Organization) ToOrganizationOutputWithContext(ctx context.Context) Orga
 // OrganizationArrayInput is an input type that accepts OrganizationArray and OrganizationArrayOutput values.
 // You can construct a concrete instance of `OrganizationArrayInput` via:
 //
-//          OrganizationArray{ OrganizationArgs{...} }
+//	OrganizationArray{ OrganizationArgs{...} }
 type OrganizationArrayInput interface {
 	pulumi.Input
 
@@ -122,7 +122,7 @@ func (i OrganizationArray) ToOrganizationArrayOutputWithContext(ctx context.Cont
 // OrganizationMapInput is an input type that accepts OrganizationMap and OrganizationMapOutput values.
 // You can construct a concrete instance of `OrganizationMapInput` via:
 //
-//          OrganizationMap{ "key": OrganizationArgs{...} }
+//	OrganizationMap{ "key": OrganizationArgs{...} }
 type OrganizationMapInput interface {
 	pulumi.Input
 
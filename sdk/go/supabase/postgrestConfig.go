@@ -0,0 +1,231 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package supabase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// PostgREST (Data API) configuration of a project. Only the settings given are managed, the others are left as is,
+// and deleting the resource restores the settings of a new project.
+//
+// The configuration of a project can be imported with its reference:
+// `pulumi import supabase:index:PostgrestConfig api <projectRef>`
+type PostgrestConfig struct {
+	pulumi.CustomResourceState
+
+	// Schemas added to the search path of every request (public and extensions by default)
+	DbExtraSearchPath pulumi.StringArrayOutput `pulumi:"dbExtraSearchPath"`
+	// Schemas exposed by the API (public, storage and graphql_public by default)
+	DbSchema pulumi.StringArrayOutput `pulumi:"dbSchema"`
+	// Maximum number of rows returned by a request (1000 by default)
+	MaxRows pulumi.IntPtrOutput `pulumi:"maxRows"`
+	// ID of the project
+	ProjectId pulumi.StringOutput `pulumi:"projectId"`
+}
+
+// NewPostgrestConfig registers a new resource with the given unique name, arguments, and options.
+func NewPostgrestConfig(ctx *pulumi.Context,
+	name string, args *PostgrestConfigArgs, opts ...pulumi.ResourceOption) (*PostgrestConfig, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.ProjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ProjectId'")
+	}
+	opts = pkgResourceDefaultOpts(opts)
+	var resource PostgrestConfig
+	err := ctx.RegisterResource("supabase:index:PostgrestConfig", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetPostgrestConfig gets an existing PostgrestConfig resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetPostgrestConfig(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *PostgrestConfigState, opts ...pulumi.ResourceOption) (*PostgrestConfig, error) {
+	var resource PostgrestConfig
+	err := ctx.ReadResource("supabase:index:PostgrestConfig", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering PostgrestConfig resources.
+type postgrestConfigState struct {
+}
+
+type PostgrestConfigState struct {
+}
+
+func (PostgrestConfigState) ElementType() reflect.Type {
+	return reflect.TypeOf((*postgrestConfigState)(nil)).Elem()
+}
+
+type postgrestConfigArgs struct {
+	// Schemas added to the search path of every request (public and extensions by default)
+	DbExtraSearchPath []string `pulumi:"dbExtraSearchPath"`
+	// Schemas exposed by the API (public, storage and graphql_public by default)
+	DbSchema []string `pulumi:"dbSchema"`
+	// Maximum number of rows returned by a request (1000 by default)
+	MaxRows *int `pulumi:"maxRows"`
+	// ID of the project
+	ProjectId string `pulumi:"projectId"`
+}
+
+// The set of arguments for constructing a PostgrestConfig resource.
+type PostgrestConfigArgs struct {
+	// Schemas added to the search path of every request (public and extensions by default)
+	DbExtraSearchPath pulumi.StringArrayInput
+	// Schemas exposed by the API (public, storage and graphql_public by default)
+	DbSchema pulumi.StringArrayInput
+	// Maximum number of rows returned by a request (1000 by default)
+	MaxRows pulumi.IntPtrInput
+	// ID of the project
+	ProjectId pulumi.StringInput
+}
+
+func (PostgrestConfigArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*postgrestConfigArgs)(nil)).Elem()
+}
+
+type PostgrestConfigInput interface {
+	pulumi.Input
+
+	ToPostgrestConfigOutput() PostgrestConfigOutput
+	ToPostgrestConfigOutputWithContext(ctx context.Context) PostgrestConfigOutput
+}
+
+func (*PostgrestConfig) ElementType() reflect.Type {
+	return reflect.TypeOf((**PostgrestConfig)(nil)).Elem()
+}
+
+func (i *PostgrestConfig) ToPostgrestConfigOutput() PostgrestConfigOutput {
+	return i.ToPostgrestConfigOutputWithContext(context.Background())
+}
+
+func (i *PostgrestConfig) ToPostgrestConfigOutputWithContext(ctx context.Context) PostgrestConfigOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PostgrestConfigOutput)
+}
+
+// PostgrestConfigArrayInput is an input type that accepts PostgrestConfigArray and PostgrestConfigArrayOutput values.
+// You can construct a concrete instance of `PostgrestConfigArrayInput` via:
+//
+//	PostgrestConfigArray{ PostgrestConfigArgs{...} }
+type PostgrestConfigArrayInput interface {
+	pulumi.Input
+
+	ToPostgrestConfigArrayOutput() PostgrestConfigArrayOutput
+	ToPostgrestConfigArrayOutputWithContext(context.Context) PostgrestConfigArrayOutput
+}
+
+type PostgrestConfigArray []PostgrestConfigInput
+
+func (PostgrestConfigArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*PostgrestConfig)(nil)).Elem()
+}
+
+func (i PostgrestConfigArray) ToPostgrestConfigArrayOutput() PostgrestConfigArrayOutput {
+	return i.ToPostgrestConfigArrayOutputWithContext(context.Background())
+}
+
+func (i PostgrestConfigArray) ToPostgrestConfigArrayOutputWithContext(ctx context.Context) PostgrestConfigArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PostgrestConfigArrayOutput)
+}
+
+// PostgrestConfigMapInput is an input type that accepts PostgrestConfigMap and PostgrestConfigMapOutput values.
+// You can construct a concrete instance of `PostgrestConfigMapInput` via:
+//
+//	PostgrestConfigMap{ "key": PostgrestConfigArgs{...} }
+type PostgrestConfigMapInput interface {
+	pulumi.Input
+
+	ToPostgrestConfigMapOutput() PostgrestConfigMapOutput
+	ToPostgrestConfigMapOutputWithContext(context.Context) PostgrestConfigMapOutput
+}
+
+type PostgrestConfigMap map[string]PostgrestConfigInput
+
+func (PostgrestConfigMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*PostgrestConfig)(nil)).Elem()
+}
+
+func (i PostgrestConfigMap) ToPostgrestConfigMapOutput() PostgrestConfigMapOutput {
+	return i.ToPostgrestConfigMapOutputWithContext(context.Background())
+}
+
+func (i PostgrestConfigMap) ToPostgrestConfigMapOutputWithContext(ctx context.Context) PostgrestConfigMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PostgrestConfigMapOutput)
+}
+
+type PostgrestConfigOutput struct{ *pulumi.OutputState }
+
+func (PostgrestConfigOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**PostgrestConfig)(nil)).Elem()
+}
+
+func (o PostgrestConfigOutput) ToPostgrestConfigOutput() PostgrestConfigOutput {
+	return o
+}
+
+func (o PostgrestConfigOutput) ToPostgrestConfigOutputWithContext(ctx context.Context) PostgrestConfigOutput {
+	return o
+}
+
+type PostgrestConfigArrayOutput struct{ *pulumi.OutputState }
+
+func (PostgrestConfigArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*PostgrestConfig)(nil)).Elem()
+}
+
+func (o PostgrestConfigArrayOutput) ToPostgrestConfigArrayOutput() PostgrestConfigArrayOutput {
+	return o
+}
+
+func (o PostgrestConfigArrayOutput) ToPostgrestConfigArrayOutputWithContext(ctx context.Context) PostgrestConfigArrayOutput {
+	return o
+}
+
+func (o PostgrestConfigArrayOutput) Index(i pulumi.IntInput) PostgrestConfigOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *PostgrestConfig {
+		return vs[0].([]*PostgrestConfig)[vs[1].(int)]
+	}).(PostgrestConfigOutput)
+}
+
+type PostgrestConfigMapOutput struct{ *pulumi.OutputState }
+
+func (PostgrestConfigMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*PostgrestConfig)(nil)).Elem()
+}
+
+func (o PostgrestConfigMapOutput) ToPostgrestConfigMapOutput() PostgrestConfigMapOutput {
+	return o
+}
+
+func (o PostgrestConfigMapOutput) ToPostgrestConfigMapOutputWithContext(ctx context.Context) PostgrestConfigMapOutput {
+	return o
+}
+
+func (o PostgrestConfigMapOutput) MapIndex(k pulumi.StringInput) PostgrestConfigOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *PostgrestConfig {
+		return vs[0].(map[string]*PostgrestConfig)[vs[1].(string)]
+	}).(PostgrestConfigOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*PostgrestConfigInput)(nil)).Elem(), &PostgrestConfig{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PostgrestConfigArrayInput)(nil)).Elem(), PostgrestConfigArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PostgrestConfigMapInput)(nil)).Elem(), PostgrestConfigMap{})
+	pulumi.RegisterOutputType(PostgrestConfigOutput{})
+	pulumi.RegisterOutputType(PostgrestConfigArrayOutput{})
+	pulumi.RegisterOutputType(PostgrestConfigMapOutput{})
+}
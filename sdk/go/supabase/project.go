@@ -11,6 +11,14 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
+// Supabase project.
+//
+// Existing projects can be imported with their reference:
+// `pulumi import supabase:index:Project my-project <projectRef>`
+// The API does not return the database password and KPS setting of a project, and only some versions of it return
+// its plan. After an import, the first update records the plan and KPS setting of the program without changing the
+// project. A plan change fails the update when the plan read back from the API is not the new one. The password is only
+// recorded along with another update, until then changing it does not replace the project.
 type Project struct {
 	pulumi.CustomResourceState
 
@@ -26,14 +34,22 @@ type Project struct {
 	DbPort pulumi.IntOutput `pulumi:"dbPort"`
 	// DB Username
 	DbUsername pulumi.StringOutput `pulumi:"dbUsername"`
+	// Refuse to delete the project while enabled
+	DeletionProtection pulumi.BoolPtrOutput `pulumi:"deletionProtection"`
 	// Supabase endpoint for client
 	Endpoint pulumi.StringOutput `pulumi:"endpoint"`
+	// KPS Enabled on the project
+	Kps_enabled pulumi.BoolPtrOutput `pulumi:"kps_enabled"`
 	// Name of the project
 	Name pulumi.StringOutput `pulumi:"name"`
 	// Organization ID of the project
 	Organization_id pulumi.StringOutput `pulumi:"organization_id"`
+	// Plan of the project
+	Plan PlanPtrOutput `pulumi:"plan"`
 	// Region of the project
 	Region RegionOutput `pulumi:"region"`
+	// Provisioning status of the project (e.g. ACTIVE_HEALTHY)
+	Status pulumi.StringPtrOutput `pulumi:"status"`
 }
 
 // NewProject registers a new resource with the given unique name, arguments, and options.
@@ -61,6 +77,9 @@ func NewProject(ctx *pulumi.Context,
 	if args.Region == nil {
 		return nil, errors.New("invalid value for required argument 'Region'")
 	}
+	if isZero(args.DeletionProtection) {
+		args.DeletionProtection = pulumi.BoolPtr(true)
+	}
 	if args.Db_pass != nil {
 		args.Db_pass = pulumi.ToSecret(args.Db_pass).(pulumi.StringOutput)
 	}
@@ -99,6 +118,8 @@ func (ProjectState) ElementType() reflect.Type {
 type projectArgs struct {
 	// Postgres password of the project
 	Db_pass string `pulumi:"db_pass"`
+	// Refuse to delete the project while enabled, set to false to allow `pulumi destroy` or replacement
+	DeletionProtection *bool `pulumi:"deletionProtection"`
 	// KPS Enabled on the project
 	Kps_enabled bool `pulumi:"kps_enabled"`
 	// Name of the project
@@ -115,6 +136,8 @@ type projectArgs struct {
 type ProjectArgs struct {
 	// Postgres password of the project
 	Db_pass pulumi.StringInput
+	// Refuse to delete the project while enabled, set to false to allow `pulumi destroy` or replacement
+	DeletionProtection pulumi.BoolPtrInput
 	// KPS Enabled on the project
 	Kps_enabled pulumi.BoolInput
 	// Name of the project
@@ -153,7 +176,7 @@ func (i *Project) ToProjectOutputWithContext(ctx context.Context) ProjectOutput
 // ProjectArrayInput is an input type that accepts ProjectArray and ProjectArrayOutput values.
 // You can construct a concrete instance of `ProjectArrayInput` via:
 //
-//          ProjectArray{ ProjectArgs{...} }
+//	ProjectArray{ ProjectArgs{...} }
 type ProjectArrayInput interface {
 	pulumi.Input
 
@@ -178,7 +201,7 @@ func (i ProjectArray) ToProjectArrayOutputWithContext(ctx context.Context) Proje
 // ProjectMapInput is an input type that accepts ProjectMap and ProjectMapOutput values.
 // You can construct a concrete instance of `ProjectMapInput` via:
 //
-//          ProjectMap{ "key": ProjectArgs{...} }
+//	ProjectMap{ "key": ProjectArgs{...} }
 type ProjectMapInput interface {
 	pulumi.Input
 
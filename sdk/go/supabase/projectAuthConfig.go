@@ -0,0 +1,255 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package supabase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Auth configuration of a project. Only the settings given are managed, the others are left as is,
+// and deleting the resource leaves the configuration of the project untouched.
+//
+// The configuration of a project can be imported with its reference, every setting is then adopted:
+// `pulumi import supabase:index:ProjectAuthConfig auth <projectRef>`
+type ProjectAuthConfig struct {
+	pulumi.CustomResourceState
+
+	// Other URLs users may be redirected to after signing in
+	AdditionalRedirectUrls pulumi.StringArrayOutput `pulumi:"additionalRedirectUrls"`
+	// Refuse the sign up of new users
+	DisableSignup pulumi.BoolPtrOutput `pulumi:"disableSignup"`
+	// Email sign in settings
+	Email ProjectAuthEmailPtrOutput `pulumi:"email"`
+	// OAuth providers by name (apple, azure, github, google, ...)
+	ExternalProviders ProjectAuthExternalProviderMapOutput `pulumi:"externalProviders"`
+	// Lifetime of the access tokens in seconds (up to 604800)
+	JwtExpiry pulumi.IntPtrOutput `pulumi:"jwtExpiry"`
+	// ID of the project
+	ProjectId pulumi.StringOutput `pulumi:"projectId"`
+	// URL users are redirected to after signing in when no redirect URL is given
+	SiteUrl pulumi.StringPtrOutput `pulumi:"siteUrl"`
+	// Phone sign in settings
+	Sms ProjectAuthSmsPtrOutput `pulumi:"sms"`
+}
+
+// NewProjectAuthConfig registers a new resource with the given unique name, arguments, and options.
+func NewProjectAuthConfig(ctx *pulumi.Context,
+	name string, args *ProjectAuthConfigArgs, opts ...pulumi.ResourceOption) (*ProjectAuthConfig, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.ProjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ProjectId'")
+	}
+	opts = pkgResourceDefaultOpts(opts)
+	var resource ProjectAuthConfig
+	err := ctx.RegisterResource("supabase:index:ProjectAuthConfig", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetProjectAuthConfig gets an existing ProjectAuthConfig resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetProjectAuthConfig(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ProjectAuthConfigState, opts ...pulumi.ResourceOption) (*ProjectAuthConfig, error) {
+	var resource ProjectAuthConfig
+	err := ctx.ReadResource("supabase:index:ProjectAuthConfig", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering ProjectAuthConfig resources.
+type projectAuthConfigState struct {
+}
+
+type ProjectAuthConfigState struct {
+}
+
+func (ProjectAuthConfigState) ElementType() reflect.Type {
+	return reflect.TypeOf((*projectAuthConfigState)(nil)).Elem()
+}
+
+type projectAuthConfigArgs struct {
+	// Other URLs users may be redirected to after signing in
+	AdditionalRedirectUrls []string `pulumi:"additionalRedirectUrls"`
+	// Refuse the sign up of new users
+	DisableSignup *bool `pulumi:"disableSignup"`
+	// Email sign in settings
+	Email *ProjectAuthEmail `pulumi:"email"`
+	// OAuth providers by name (apple, azure, github, google, ...)
+	ExternalProviders map[string]ProjectAuthExternalProvider `pulumi:"externalProviders"`
+	// Lifetime of the access tokens in seconds (up to 604800)
+	JwtExpiry *int `pulumi:"jwtExpiry"`
+	// ID of the project
+	ProjectId string `pulumi:"projectId"`
+	// URL users are redirected to after signing in when no redirect URL is given
+	SiteUrl *string `pulumi:"siteUrl"`
+	// Phone sign in settings
+	Sms *ProjectAuthSms `pulumi:"sms"`
+}
+
+// The set of arguments for constructing a ProjectAuthConfig resource.
+type ProjectAuthConfigArgs struct {
+	// Other URLs users may be redirected to after signing in
+	AdditionalRedirectUrls pulumi.StringArrayInput
+	// Refuse the sign up of new users
+	DisableSignup pulumi.BoolPtrInput
+	// Email sign in settings
+	Email ProjectAuthEmailPtrInput
+	// OAuth providers by name (apple, azure, github, google, ...)
+	ExternalProviders ProjectAuthExternalProviderMapInput
+	// Lifetime of the access tokens in seconds (up to 604800)
+	JwtExpiry pulumi.IntPtrInput
+	// ID of the project
+	ProjectId pulumi.StringInput
+	// URL users are redirected to after signing in when no redirect URL is given
+	SiteUrl pulumi.StringPtrInput
+	// Phone sign in settings
+	Sms ProjectAuthSmsPtrInput
+}
+
+func (ProjectAuthConfigArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*projectAuthConfigArgs)(nil)).Elem()
+}
+
+type ProjectAuthConfigInput interface {
+	pulumi.Input
+
+	ToProjectAuthConfigOutput() ProjectAuthConfigOutput
+	ToProjectAuthConfigOutputWithContext(ctx context.Context) ProjectAuthConfigOutput
+}
+
+func (*ProjectAuthConfig) ElementType() reflect.Type {
+	return reflect.TypeOf((**ProjectAuthConfig)(nil)).Elem()
+}
+
+func (i *ProjectAuthConfig) ToProjectAuthConfigOutput() ProjectAuthConfigOutput {
+	return i.ToProjectAuthConfigOutputWithContext(context.Background())
+}
+
+func (i *ProjectAuthConfig) ToProjectAuthConfigOutputWithContext(ctx context.Context) ProjectAuthConfigOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectAuthConfigOutput)
+}
+
+// ProjectAuthConfigArrayInput is an input type that accepts ProjectAuthConfigArray and ProjectAuthConfigArrayOutput values.
+// You can construct a concrete instance of `ProjectAuthConfigArrayInput` via:
+//
+//	ProjectAuthConfigArray{ ProjectAuthConfigArgs{...} }
+type ProjectAuthConfigArrayInput interface {
+	pulumi.Input
+
+	ToProjectAuthConfigArrayOutput() ProjectAuthConfigArrayOutput
+	ToProjectAuthConfigArrayOutputWithContext(context.Context) ProjectAuthConfigArrayOutput
+}
+
+type ProjectAuthConfigArray []ProjectAuthConfigInput
+
+func (ProjectAuthConfigArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*ProjectAuthConfig)(nil)).Elem()
+}
+
+func (i ProjectAuthConfigArray) ToProjectAuthConfigArrayOutput() ProjectAuthConfigArrayOutput {
+	return i.ToProjectAuthConfigArrayOutputWithContext(context.Background())
+}
+
+func (i ProjectAuthConfigArray) ToProjectAuthConfigArrayOutputWithContext(ctx context.Context) ProjectAuthConfigArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectAuthConfigArrayOutput)
+}
+
+// ProjectAuthConfigMapInput is an input type that accepts ProjectAuthConfigMap and ProjectAuthConfigMapOutput values.
+// You can construct a concrete instance of `ProjectAuthConfigMapInput` via:
+//
+//	ProjectAuthConfigMap{ "key": ProjectAuthConfigArgs{...} }
+type ProjectAuthConfigMapInput interface {
+	pulumi.Input
+
+	ToProjectAuthConfigMapOutput() ProjectAuthConfigMapOutput
+	ToProjectAuthConfigMapOutputWithContext(context.Context) ProjectAuthConfigMapOutput
+}
+
+type ProjectAuthConfigMap map[string]ProjectAuthConfigInput
+
+func (ProjectAuthConfigMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*ProjectAuthConfig)(nil)).Elem()
+}
+
+func (i ProjectAuthConfigMap) ToProjectAuthConfigMapOutput() ProjectAuthConfigMapOutput {
+	return i.ToProjectAuthConfigMapOutputWithContext(context.Background())
+}
+
+func (i ProjectAuthConfigMap) ToProjectAuthConfigMapOutputWithContext(ctx context.Context) ProjectAuthConfigMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectAuthConfigMapOutput)
+}
+
+type ProjectAuthConfigOutput struct{ *pulumi.OutputState }
+
+func (ProjectAuthConfigOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ProjectAuthConfig)(nil)).Elem()
+}
+
+func (o ProjectAuthConfigOutput) ToProjectAuthConfigOutput() ProjectAuthConfigOutput {
+	return o
+}
+
+func (o ProjectAuthConfigOutput) ToProjectAuthConfigOutputWithContext(ctx context.Context) ProjectAuthConfigOutput {
+	return o
+}
+
+type ProjectAuthConfigArrayOutput struct{ *pulumi.OutputState }
+
+func (ProjectAuthConfigArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*ProjectAuthConfig)(nil)).Elem()
+}
+
+func (o ProjectAuthConfigArrayOutput) ToProjectAuthConfigArrayOutput() ProjectAuthConfigArrayOutput {
+	return o
+}
+
+func (o ProjectAuthConfigArrayOutput) ToProjectAuthConfigArrayOutputWithContext(ctx context.Context) ProjectAuthConfigArrayOutput {
+	return o
+}
+
+func (o ProjectAuthConfigArrayOutput) Index(i pulumi.IntInput) ProjectAuthConfigOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *ProjectAuthConfig {
+		return vs[0].([]*ProjectAuthConfig)[vs[1].(int)]
+	}).(ProjectAuthConfigOutput)
+}
+
+type ProjectAuthConfigMapOutput struct{ *pulumi.OutputState }
+
+func (ProjectAuthConfigMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*ProjectAuthConfig)(nil)).Elem()
+}
+
+func (o ProjectAuthConfigMapOutput) ToProjectAuthConfigMapOutput() ProjectAuthConfigMapOutput {
+	return o
+}
+
+func (o ProjectAuthConfigMapOutput) ToProjectAuthConfigMapOutputWithContext(ctx context.Context) ProjectAuthConfigMapOutput {
+	return o
+}
+
+func (o ProjectAuthConfigMapOutput) MapIndex(k pulumi.StringInput) ProjectAuthConfigOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *ProjectAuthConfig {
+		return vs[0].(map[string]*ProjectAuthConfig)[vs[1].(string)]
+	}).(ProjectAuthConfigOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*ProjectAuthConfigInput)(nil)).Elem(), &ProjectAuthConfig{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ProjectAuthConfigArrayInput)(nil)).Elem(), ProjectAuthConfigArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ProjectAuthConfigMapInput)(nil)).Elem(), ProjectAuthConfigMap{})
+	pulumi.RegisterOutputType(ProjectAuthConfigOutput{})
+	pulumi.RegisterOutputType(ProjectAuthConfigArrayOutput{})
+	pulumi.RegisterOutputType(ProjectAuthConfigMapOutput{})
+}
@@ -230,7 +230,7 @@ func (o PlanPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.
 // PlanInput is an input type that accepts PlanArgs and PlanOutput values.
 // You can construct a concrete instance of `PlanInput` via:
 //
-//          PlanArgs{...}
+//	PlanArgs{...}
 type PlanInput interface {
 	pulumi.Input
 
@@ -404,7 +404,7 @@ func (o RegionPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulum
 // RegionInput is an input type that accepts RegionArgs and RegionOutput values.
 // You can construct a concrete instance of `RegionInput` via:
 //
-//          RegionArgs{...}
+//	RegionArgs{...}
 type RegionInput interface {
 	pulumi.Input
 
@@ -0,0 +1,996 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package supabase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// DNS record to create at the DNS provider of a hostname
+type DnsRecord struct {
+	// Name of the record
+	Name string `pulumi:"name"`
+	// Type of the record (CNAME or TXT)
+	Type string `pulumi:"type"`
+	// Value of the record
+	Value string `pulumi:"value"`
+}
+
+// DNS record to create at the DNS provider of a hostname
+type DnsRecordOutput struct{ *pulumi.OutputState }
+
+func (DnsRecordOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DnsRecord)(nil)).Elem()
+}
+
+func (o DnsRecordOutput) ToDnsRecordOutput() DnsRecordOutput {
+	return o
+}
+
+func (o DnsRecordOutput) ToDnsRecordOutputWithContext(ctx context.Context) DnsRecordOutput {
+	return o
+}
+
+// Name of the record
+func (o DnsRecordOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v DnsRecord) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// Type of the record (CNAME or TXT)
+func (o DnsRecordOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v DnsRecord) string { return v.Type }).(pulumi.StringOutput)
+}
+
+// Value of the record
+func (o DnsRecordOutput) Value() pulumi.StringOutput {
+	return o.ApplyT(func(v DnsRecord) string { return v.Value }).(pulumi.StringOutput)
+}
+
+type DnsRecordArrayOutput struct{ *pulumi.OutputState }
+
+func (DnsRecordArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]DnsRecord)(nil)).Elem()
+}
+
+func (o DnsRecordArrayOutput) ToDnsRecordArrayOutput() DnsRecordArrayOutput {
+	return o
+}
+
+func (o DnsRecordArrayOutput) ToDnsRecordArrayOutputWithContext(ctx context.Context) DnsRecordArrayOutput {
+	return o
+}
+
+func (o DnsRecordArrayOutput) Index(i pulumi.IntInput) DnsRecordOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) DnsRecord {
+		return vs[0].([]DnsRecord)[vs[1].(int)]
+	}).(DnsRecordOutput)
+}
+
+type ProjectAuthEmail struct {
+	// Confirm the email of new users without sending a confirmation email
+	Autoconfirm *bool `pulumi:"autoconfirm"`
+	// Allow users to sign up and sign in with their email
+	Enabled *bool `pulumi:"enabled"`
+	// Custom SMTP server the auth emails are sent with
+	Smtp *ProjectAuthSmtp `pulumi:"smtp"`
+}
+
+// ProjectAuthEmailInput is an input type that accepts ProjectAuthEmailArgs and ProjectAuthEmailOutput values.
+// You can construct a concrete instance of `ProjectAuthEmailInput` via:
+//
+//	ProjectAuthEmailArgs{...}
+type ProjectAuthEmailInput interface {
+	pulumi.Input
+
+	ToProjectAuthEmailOutput() ProjectAuthEmailOutput
+	ToProjectAuthEmailOutputWithContext(context.Context) ProjectAuthEmailOutput
+}
+
+type ProjectAuthEmailArgs struct {
+	// Confirm the email of new users without sending a confirmation email
+	Autoconfirm pulumi.BoolPtrInput `pulumi:"autoconfirm"`
+	// Allow users to sign up and sign in with their email
+	Enabled pulumi.BoolPtrInput `pulumi:"enabled"`
+	// Custom SMTP server the auth emails are sent with
+	Smtp ProjectAuthSmtpPtrInput `pulumi:"smtp"`
+}
+
+func (ProjectAuthEmailArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProjectAuthEmail)(nil)).Elem()
+}
+
+func (i ProjectAuthEmailArgs) ToProjectAuthEmailOutput() ProjectAuthEmailOutput {
+	return i.ToProjectAuthEmailOutputWithContext(context.Background())
+}
+
+func (i ProjectAuthEmailArgs) ToProjectAuthEmailOutputWithContext(ctx context.Context) ProjectAuthEmailOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectAuthEmailOutput)
+}
+
+func (i ProjectAuthEmailArgs) ToProjectAuthEmailPtrOutput() ProjectAuthEmailPtrOutput {
+	return i.ToProjectAuthEmailPtrOutputWithContext(context.Background())
+}
+
+func (i ProjectAuthEmailArgs) ToProjectAuthEmailPtrOutputWithContext(ctx context.Context) ProjectAuthEmailPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectAuthEmailOutput).ToProjectAuthEmailPtrOutputWithContext(ctx)
+}
+
+// ProjectAuthEmailPtrInput is an input type that accepts ProjectAuthEmailArgs, ProjectAuthEmailPtr and ProjectAuthEmailPtrOutput values.
+// You can construct a concrete instance of `ProjectAuthEmailPtrInput` via:
+//
+//	        ProjectAuthEmailArgs{...}
+//
+//	or:
+//
+//	        nil
+type ProjectAuthEmailPtrInput interface {
+	pulumi.Input
+
+	ToProjectAuthEmailPtrOutput() ProjectAuthEmailPtrOutput
+	ToProjectAuthEmailPtrOutputWithContext(context.Context) ProjectAuthEmailPtrOutput
+}
+
+type projectAuthEmailPtrType ProjectAuthEmailArgs
+
+func ProjectAuthEmailPtr(v *ProjectAuthEmailArgs) ProjectAuthEmailPtrInput {
+	return (*projectAuthEmailPtrType)(v)
+}
+
+func (*projectAuthEmailPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ProjectAuthEmail)(nil)).Elem()
+}
+
+func (i *projectAuthEmailPtrType) ToProjectAuthEmailPtrOutput() ProjectAuthEmailPtrOutput {
+	return i.ToProjectAuthEmailPtrOutputWithContext(context.Background())
+}
+
+func (i *projectAuthEmailPtrType) ToProjectAuthEmailPtrOutputWithContext(ctx context.Context) ProjectAuthEmailPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectAuthEmailPtrOutput)
+}
+
+type ProjectAuthEmailOutput struct{ *pulumi.OutputState }
+
+func (ProjectAuthEmailOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProjectAuthEmail)(nil)).Elem()
+}
+
+func (o ProjectAuthEmailOutput) ToProjectAuthEmailOutput() ProjectAuthEmailOutput {
+	return o
+}
+
+func (o ProjectAuthEmailOutput) ToProjectAuthEmailOutputWithContext(ctx context.Context) ProjectAuthEmailOutput {
+	return o
+}
+
+func (o ProjectAuthEmailOutput) ToProjectAuthEmailPtrOutput() ProjectAuthEmailPtrOutput {
+	return o.ToProjectAuthEmailPtrOutputWithContext(context.Background())
+}
+
+func (o ProjectAuthEmailOutput) ToProjectAuthEmailPtrOutputWithContext(ctx context.Context) ProjectAuthEmailPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ProjectAuthEmail) *ProjectAuthEmail {
+		return &v
+	}).(ProjectAuthEmailPtrOutput)
+}
+
+// Confirm the email of new users without sending a confirmation email
+func (o ProjectAuthEmailOutput) Autoconfirm() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ProjectAuthEmail) *bool { return v.Autoconfirm }).(pulumi.BoolPtrOutput)
+}
+
+// Allow users to sign up and sign in with their email
+func (o ProjectAuthEmailOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ProjectAuthEmail) *bool { return v.Enabled }).(pulumi.BoolPtrOutput)
+}
+
+// Custom SMTP server the auth emails are sent with
+func (o ProjectAuthEmailOutput) Smtp() ProjectAuthSmtpPtrOutput {
+	return o.ApplyT(func(v ProjectAuthEmail) *ProjectAuthSmtp { return v.Smtp }).(ProjectAuthSmtpPtrOutput)
+}
+
+type ProjectAuthEmailPtrOutput struct{ *pulumi.OutputState }
+
+func (ProjectAuthEmailPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ProjectAuthEmail)(nil)).Elem()
+}
+
+func (o ProjectAuthEmailPtrOutput) ToProjectAuthEmailPtrOutput() ProjectAuthEmailPtrOutput {
+	return o
+}
+
+func (o ProjectAuthEmailPtrOutput) ToProjectAuthEmailPtrOutputWithContext(ctx context.Context) ProjectAuthEmailPtrOutput {
+	return o
+}
+
+func (o ProjectAuthEmailPtrOutput) Elem() ProjectAuthEmailOutput {
+	return o.ApplyT(func(v *ProjectAuthEmail) ProjectAuthEmail {
+		if v != nil {
+			return *v
+		}
+		var ret ProjectAuthEmail
+		return ret
+	}).(ProjectAuthEmailOutput)
+}
+
+// Confirm the email of new users without sending a confirmation email
+func (o ProjectAuthEmailPtrOutput) Autoconfirm() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ProjectAuthEmail) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Autoconfirm
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Allow users to sign up and sign in with their email
+func (o ProjectAuthEmailPtrOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ProjectAuthEmail) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Enabled
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Custom SMTP server the auth emails are sent with
+func (o ProjectAuthEmailPtrOutput) Smtp() ProjectAuthSmtpPtrOutput {
+	return o.ApplyT(func(v *ProjectAuthEmail) *ProjectAuthSmtp {
+		if v == nil {
+			return nil
+		}
+		return v.Smtp
+	}).(ProjectAuthSmtpPtrOutput)
+}
+
+type ProjectAuthExternalProvider struct {
+	// OAuth client ID
+	ClientId *string `pulumi:"clientId"`
+	// Allow users to sign in with the provider
+	Enabled *bool `pulumi:"enabled"`
+	// OAuth client secret
+	Secret *string `pulumi:"secret"`
+	// URL of the provider, for the self-hosted ones (azure, gitlab, keycloak, workos)
+	Url *string `pulumi:"url"`
+}
+
+// ProjectAuthExternalProviderInput is an input type that accepts ProjectAuthExternalProviderArgs and ProjectAuthExternalProviderOutput values.
+// You can construct a concrete instance of `ProjectAuthExternalProviderInput` via:
+//
+//	ProjectAuthExternalProviderArgs{...}
+type ProjectAuthExternalProviderInput interface {
+	pulumi.Input
+
+	ToProjectAuthExternalProviderOutput() ProjectAuthExternalProviderOutput
+	ToProjectAuthExternalProviderOutputWithContext(context.Context) ProjectAuthExternalProviderOutput
+}
+
+type ProjectAuthExternalProviderArgs struct {
+	// OAuth client ID
+	ClientId pulumi.StringPtrInput `pulumi:"clientId"`
+	// Allow users to sign in with the provider
+	Enabled pulumi.BoolPtrInput `pulumi:"enabled"`
+	// OAuth client secret
+	Secret pulumi.StringPtrInput `pulumi:"secret"`
+	// URL of the provider, for the self-hosted ones (azure, gitlab, keycloak, workos)
+	Url pulumi.StringPtrInput `pulumi:"url"`
+}
+
+func (ProjectAuthExternalProviderArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProjectAuthExternalProvider)(nil)).Elem()
+}
+
+func (i ProjectAuthExternalProviderArgs) ToProjectAuthExternalProviderOutput() ProjectAuthExternalProviderOutput {
+	return i.ToProjectAuthExternalProviderOutputWithContext(context.Background())
+}
+
+func (i ProjectAuthExternalProviderArgs) ToProjectAuthExternalProviderOutputWithContext(ctx context.Context) ProjectAuthExternalProviderOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectAuthExternalProviderOutput)
+}
+
+// ProjectAuthExternalProviderMapInput is an input type that accepts ProjectAuthExternalProviderMap and ProjectAuthExternalProviderMapOutput values.
+// You can construct a concrete instance of `ProjectAuthExternalProviderMapInput` via:
+//
+//	ProjectAuthExternalProviderMap{ "key": ProjectAuthExternalProviderArgs{...} }
+type ProjectAuthExternalProviderMapInput interface {
+	pulumi.Input
+
+	ToProjectAuthExternalProviderMapOutput() ProjectAuthExternalProviderMapOutput
+	ToProjectAuthExternalProviderMapOutputWithContext(context.Context) ProjectAuthExternalProviderMapOutput
+}
+
+type ProjectAuthExternalProviderMap map[string]ProjectAuthExternalProviderInput
+
+func (ProjectAuthExternalProviderMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]ProjectAuthExternalProvider)(nil)).Elem()
+}
+
+func (i ProjectAuthExternalProviderMap) ToProjectAuthExternalProviderMapOutput() ProjectAuthExternalProviderMapOutput {
+	return i.ToProjectAuthExternalProviderMapOutputWithContext(context.Background())
+}
+
+func (i ProjectAuthExternalProviderMap) ToProjectAuthExternalProviderMapOutputWithContext(ctx context.Context) ProjectAuthExternalProviderMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectAuthExternalProviderMapOutput)
+}
+
+type ProjectAuthExternalProviderOutput struct{ *pulumi.OutputState }
+
+func (ProjectAuthExternalProviderOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProjectAuthExternalProvider)(nil)).Elem()
+}
+
+func (o ProjectAuthExternalProviderOutput) ToProjectAuthExternalProviderOutput() ProjectAuthExternalProviderOutput {
+	return o
+}
+
+func (o ProjectAuthExternalProviderOutput) ToProjectAuthExternalProviderOutputWithContext(ctx context.Context) ProjectAuthExternalProviderOutput {
+	return o
+}
+
+// OAuth client ID
+func (o ProjectAuthExternalProviderOutput) ClientId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ProjectAuthExternalProvider) *string { return v.ClientId }).(pulumi.StringPtrOutput)
+}
+
+// Allow users to sign in with the provider
+func (o ProjectAuthExternalProviderOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ProjectAuthExternalProvider) *bool { return v.Enabled }).(pulumi.BoolPtrOutput)
+}
+
+// OAuth client secret
+func (o ProjectAuthExternalProviderOutput) Secret() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ProjectAuthExternalProvider) *string { return v.Secret }).(pulumi.StringPtrOutput)
+}
+
+// URL of the provider, for the self-hosted ones (azure, gitlab, keycloak, workos)
+func (o ProjectAuthExternalProviderOutput) Url() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ProjectAuthExternalProvider) *string { return v.Url }).(pulumi.StringPtrOutput)
+}
+
+type ProjectAuthExternalProviderMapOutput struct{ *pulumi.OutputState }
+
+func (ProjectAuthExternalProviderMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]ProjectAuthExternalProvider)(nil)).Elem()
+}
+
+func (o ProjectAuthExternalProviderMapOutput) ToProjectAuthExternalProviderMapOutput() ProjectAuthExternalProviderMapOutput {
+	return o
+}
+
+func (o ProjectAuthExternalProviderMapOutput) ToProjectAuthExternalProviderMapOutputWithContext(ctx context.Context) ProjectAuthExternalProviderMapOutput {
+	return o
+}
+
+func (o ProjectAuthExternalProviderMapOutput) MapIndex(k pulumi.StringInput) ProjectAuthExternalProviderOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) ProjectAuthExternalProvider {
+		return vs[0].(map[string]ProjectAuthExternalProvider)[vs[1].(string)]
+	}).(ProjectAuthExternalProviderOutput)
+}
+
+type ProjectAuthSms struct {
+	// Confirm the phone number of new users without sending a confirmation SMS
+	Autoconfirm *bool `pulumi:"autoconfirm"`
+	// Allow users to sign up and sign in with their phone number
+	Enabled *bool `pulumi:"enabled"`
+	// SMS provider (twilio, twilio_verify, messagebird, textlocal or vonage)
+	Provider *string `pulumi:"provider"`
+	// Twilio credentials, used by the twilio provider
+	Twilio *ProjectAuthTwilio `pulumi:"twilio"`
+}
+
+// ProjectAuthSmsInput is an input type that accepts ProjectAuthSmsArgs and ProjectAuthSmsOutput values.
+// You can construct a concrete instance of `ProjectAuthSmsInput` via:
+//
+//	ProjectAuthSmsArgs{...}
+type ProjectAuthSmsInput interface {
+	pulumi.Input
+
+	ToProjectAuthSmsOutput() ProjectAuthSmsOutput
+	ToProjectAuthSmsOutputWithContext(context.Context) ProjectAuthSmsOutput
+}
+
+type ProjectAuthSmsArgs struct {
+	// Confirm the phone number of new users without sending a confirmation SMS
+	Autoconfirm pulumi.BoolPtrInput `pulumi:"autoconfirm"`
+	// Allow users to sign up and sign in with their phone number
+	Enabled pulumi.BoolPtrInput `pulumi:"enabled"`
+	// SMS provider (twilio, twilio_verify, messagebird, textlocal or vonage)
+	Provider pulumi.StringPtrInput `pulumi:"provider"`
+	// Twilio credentials, used by the twilio provider
+	Twilio ProjectAuthTwilioPtrInput `pulumi:"twilio"`
+}
+
+func (ProjectAuthSmsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProjectAuthSms)(nil)).Elem()
+}
+
+func (i ProjectAuthSmsArgs) ToProjectAuthSmsOutput() ProjectAuthSmsOutput {
+	return i.ToProjectAuthSmsOutputWithContext(context.Background())
+}
+
+func (i ProjectAuthSmsArgs) ToProjectAuthSmsOutputWithContext(ctx context.Context) ProjectAuthSmsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectAuthSmsOutput)
+}
+
+func (i ProjectAuthSmsArgs) ToProjectAuthSmsPtrOutput() ProjectAuthSmsPtrOutput {
+	return i.ToProjectAuthSmsPtrOutputWithContext(context.Background())
+}
+
+func (i ProjectAuthSmsArgs) ToProjectAuthSmsPtrOutputWithContext(ctx context.Context) ProjectAuthSmsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectAuthSmsOutput).ToProjectAuthSmsPtrOutputWithContext(ctx)
+}
+
+// ProjectAuthSmsPtrInput is an input type that accepts ProjectAuthSmsArgs, ProjectAuthSmsPtr and ProjectAuthSmsPtrOutput values.
+// You can construct a concrete instance of `ProjectAuthSmsPtrInput` via:
+//
+//	        ProjectAuthSmsArgs{...}
+//
+//	or:
+//
+//	        nil
+type ProjectAuthSmsPtrInput interface {
+	pulumi.Input
+
+	ToProjectAuthSmsPtrOutput() ProjectAuthSmsPtrOutput
+	ToProjectAuthSmsPtrOutputWithContext(context.Context) ProjectAuthSmsPtrOutput
+}
+
+type projectAuthSmsPtrType ProjectAuthSmsArgs
+
+func ProjectAuthSmsPtr(v *ProjectAuthSmsArgs) ProjectAuthSmsPtrInput {
+	return (*projectAuthSmsPtrType)(v)
+}
+
+func (*projectAuthSmsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ProjectAuthSms)(nil)).Elem()
+}
+
+func (i *projectAuthSmsPtrType) ToProjectAuthSmsPtrOutput() ProjectAuthSmsPtrOutput {
+	return i.ToProjectAuthSmsPtrOutputWithContext(context.Background())
+}
+
+func (i *projectAuthSmsPtrType) ToProjectAuthSmsPtrOutputWithContext(ctx context.Context) ProjectAuthSmsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectAuthSmsPtrOutput)
+}
+
+type ProjectAuthSmsOutput struct{ *pulumi.OutputState }
+
+func (ProjectAuthSmsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProjectAuthSms)(nil)).Elem()
+}
+
+func (o ProjectAuthSmsOutput) ToProjectAuthSmsOutput() ProjectAuthSmsOutput {
+	return o
+}
+
+func (o ProjectAuthSmsOutput) ToProjectAuthSmsOutputWithContext(ctx context.Context) ProjectAuthSmsOutput {
+	return o
+}
+
+func (o ProjectAuthSmsOutput) ToProjectAuthSmsPtrOutput() ProjectAuthSmsPtrOutput {
+	return o.ToProjectAuthSmsPtrOutputWithContext(context.Background())
+}
+
+func (o ProjectAuthSmsOutput) ToProjectAuthSmsPtrOutputWithContext(ctx context.Context) ProjectAuthSmsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ProjectAuthSms) *ProjectAuthSms {
+		return &v
+	}).(ProjectAuthSmsPtrOutput)
+}
+
+// Confirm the phone number of new users without sending a confirmation SMS
+func (o ProjectAuthSmsOutput) Autoconfirm() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ProjectAuthSms) *bool { return v.Autoconfirm }).(pulumi.BoolPtrOutput)
+}
+
+// Allow users to sign up and sign in with their phone number
+func (o ProjectAuthSmsOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ProjectAuthSms) *bool { return v.Enabled }).(pulumi.BoolPtrOutput)
+}
+
+// SMS provider (twilio, twilio_verify, messagebird, textlocal or vonage)
+func (o ProjectAuthSmsOutput) Provider() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ProjectAuthSms) *string { return v.Provider }).(pulumi.StringPtrOutput)
+}
+
+// Twilio credentials, used by the twilio provider
+func (o ProjectAuthSmsOutput) Twilio() ProjectAuthTwilioPtrOutput {
+	return o.ApplyT(func(v ProjectAuthSms) *ProjectAuthTwilio { return v.Twilio }).(ProjectAuthTwilioPtrOutput)
+}
+
+type ProjectAuthSmsPtrOutput struct{ *pulumi.OutputState }
+
+func (ProjectAuthSmsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ProjectAuthSms)(nil)).Elem()
+}
+
+func (o ProjectAuthSmsPtrOutput) ToProjectAuthSmsPtrOutput() ProjectAuthSmsPtrOutput {
+	return o
+}
+
+func (o ProjectAuthSmsPtrOutput) ToProjectAuthSmsPtrOutputWithContext(ctx context.Context) ProjectAuthSmsPtrOutput {
+	return o
+}
+
+func (o ProjectAuthSmsPtrOutput) Elem() ProjectAuthSmsOutput {
+	return o.ApplyT(func(v *ProjectAuthSms) ProjectAuthSms {
+		if v != nil {
+			return *v
+		}
+		var ret ProjectAuthSms
+		return ret
+	}).(ProjectAuthSmsOutput)
+}
+
+// Confirm the phone number of new users without sending a confirmation SMS
+func (o ProjectAuthSmsPtrOutput) Autoconfirm() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ProjectAuthSms) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Autoconfirm
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Allow users to sign up and sign in with their phone number
+func (o ProjectAuthSmsPtrOutput) Enabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ProjectAuthSms) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Enabled
+	}).(pulumi.BoolPtrOutput)
+}
+
+// SMS provider (twilio, twilio_verify, messagebird, textlocal or vonage)
+func (o ProjectAuthSmsPtrOutput) Provider() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ProjectAuthSms) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Provider
+	}).(pulumi.StringPtrOutput)
+}
+
+// Twilio credentials, used by the twilio provider
+func (o ProjectAuthSmsPtrOutput) Twilio() ProjectAuthTwilioPtrOutput {
+	return o.ApplyT(func(v *ProjectAuthSms) *ProjectAuthTwilio {
+		if v == nil {
+			return nil
+		}
+		return v.Twilio
+	}).(ProjectAuthTwilioPtrOutput)
+}
+
+type ProjectAuthSmtp struct {
+	// Email address the auth emails are sent from
+	AdminEmail *string `pulumi:"adminEmail"`
+	// Hostname of the SMTP server
+	Host *string `pulumi:"host"`
+	// Password of the SMTP server
+	Pass *string `pulumi:"pass"`
+	// Port of the SMTP server
+	Port *int `pulumi:"port"`
+	// Name the auth emails are sent from
+	SenderName *string `pulumi:"senderName"`
+	// Username of the SMTP server
+	User *string `pulumi:"user"`
+}
+
+// ProjectAuthSmtpInput is an input type that accepts ProjectAuthSmtpArgs and ProjectAuthSmtpOutput values.
+// You can construct a concrete instance of `ProjectAuthSmtpInput` via:
+//
+//	ProjectAuthSmtpArgs{...}
+type ProjectAuthSmtpInput interface {
+	pulumi.Input
+
+	ToProjectAuthSmtpOutput() ProjectAuthSmtpOutput
+	ToProjectAuthSmtpOutputWithContext(context.Context) ProjectAuthSmtpOutput
+}
+
+type ProjectAuthSmtpArgs struct {
+	// Email address the auth emails are sent from
+	AdminEmail pulumi.StringPtrInput `pulumi:"adminEmail"`
+	// Hostname of the SMTP server
+	Host pulumi.StringPtrInput `pulumi:"host"`
+	// Password of the SMTP server
+	Pass pulumi.StringPtrInput `pulumi:"pass"`
+	// Port of the SMTP server
+	Port pulumi.IntPtrInput `pulumi:"port"`
+	// Name the auth emails are sent from
+	SenderName pulumi.StringPtrInput `pulumi:"senderName"`
+	// Username of the SMTP server
+	User pulumi.StringPtrInput `pulumi:"user"`
+}
+
+func (ProjectAuthSmtpArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProjectAuthSmtp)(nil)).Elem()
+}
+
+func (i ProjectAuthSmtpArgs) ToProjectAuthSmtpOutput() ProjectAuthSmtpOutput {
+	return i.ToProjectAuthSmtpOutputWithContext(context.Background())
+}
+
+func (i ProjectAuthSmtpArgs) ToProjectAuthSmtpOutputWithContext(ctx context.Context) ProjectAuthSmtpOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectAuthSmtpOutput)
+}
+
+func (i ProjectAuthSmtpArgs) ToProjectAuthSmtpPtrOutput() ProjectAuthSmtpPtrOutput {
+	return i.ToProjectAuthSmtpPtrOutputWithContext(context.Background())
+}
+
+func (i ProjectAuthSmtpArgs) ToProjectAuthSmtpPtrOutputWithContext(ctx context.Context) ProjectAuthSmtpPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectAuthSmtpOutput).ToProjectAuthSmtpPtrOutputWithContext(ctx)
+}
+
+// ProjectAuthSmtpPtrInput is an input type that accepts ProjectAuthSmtpArgs, ProjectAuthSmtpPtr and ProjectAuthSmtpPtrOutput values.
+// You can construct a concrete instance of `ProjectAuthSmtpPtrInput` via:
+//
+//	        ProjectAuthSmtpArgs{...}
+//
+//	or:
+//
+//	        nil
+type ProjectAuthSmtpPtrInput interface {
+	pulumi.Input
+
+	ToProjectAuthSmtpPtrOutput() ProjectAuthSmtpPtrOutput
+	ToProjectAuthSmtpPtrOutputWithContext(context.Context) ProjectAuthSmtpPtrOutput
+}
+
+type projectAuthSmtpPtrType ProjectAuthSmtpArgs
+
+func ProjectAuthSmtpPtr(v *ProjectAuthSmtpArgs) ProjectAuthSmtpPtrInput {
+	return (*projectAuthSmtpPtrType)(v)
+}
+
+func (*projectAuthSmtpPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ProjectAuthSmtp)(nil)).Elem()
+}
+
+func (i *projectAuthSmtpPtrType) ToProjectAuthSmtpPtrOutput() ProjectAuthSmtpPtrOutput {
+	return i.ToProjectAuthSmtpPtrOutputWithContext(context.Background())
+}
+
+func (i *projectAuthSmtpPtrType) ToProjectAuthSmtpPtrOutputWithContext(ctx context.Context) ProjectAuthSmtpPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectAuthSmtpPtrOutput)
+}
+
+type ProjectAuthSmtpOutput struct{ *pulumi.OutputState }
+
+func (ProjectAuthSmtpOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProjectAuthSmtp)(nil)).Elem()
+}
+
+func (o ProjectAuthSmtpOutput) ToProjectAuthSmtpOutput() ProjectAuthSmtpOutput {
+	return o
+}
+
+func (o ProjectAuthSmtpOutput) ToProjectAuthSmtpOutputWithContext(ctx context.Context) ProjectAuthSmtpOutput {
+	return o
+}
+
+func (o ProjectAuthSmtpOutput) ToProjectAuthSmtpPtrOutput() ProjectAuthSmtpPtrOutput {
+	return o.ToProjectAuthSmtpPtrOutputWithContext(context.Background())
+}
+
+func (o ProjectAuthSmtpOutput) ToProjectAuthSmtpPtrOutputWithContext(ctx context.Context) ProjectAuthSmtpPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ProjectAuthSmtp) *ProjectAuthSmtp {
+		return &v
+	}).(ProjectAuthSmtpPtrOutput)
+}
+
+// Email address the auth emails are sent from
+func (o ProjectAuthSmtpOutput) AdminEmail() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ProjectAuthSmtp) *string { return v.AdminEmail }).(pulumi.StringPtrOutput)
+}
+
+// Hostname of the SMTP server
+func (o ProjectAuthSmtpOutput) Host() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ProjectAuthSmtp) *string { return v.Host }).(pulumi.StringPtrOutput)
+}
+
+// Password of the SMTP server
+func (o ProjectAuthSmtpOutput) Pass() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ProjectAuthSmtp) *string { return v.Pass }).(pulumi.StringPtrOutput)
+}
+
+// Port of the SMTP server
+func (o ProjectAuthSmtpOutput) Port() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ProjectAuthSmtp) *int { return v.Port }).(pulumi.IntPtrOutput)
+}
+
+// Name the auth emails are sent from
+func (o ProjectAuthSmtpOutput) SenderName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ProjectAuthSmtp) *string { return v.SenderName }).(pulumi.StringPtrOutput)
+}
+
+// Username of the SMTP server
+func (o ProjectAuthSmtpOutput) User() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ProjectAuthSmtp) *string { return v.User }).(pulumi.StringPtrOutput)
+}
+
+type ProjectAuthSmtpPtrOutput struct{ *pulumi.OutputState }
+
+func (ProjectAuthSmtpPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ProjectAuthSmtp)(nil)).Elem()
+}
+
+func (o ProjectAuthSmtpPtrOutput) ToProjectAuthSmtpPtrOutput() ProjectAuthSmtpPtrOutput {
+	return o
+}
+
+func (o ProjectAuthSmtpPtrOutput) ToProjectAuthSmtpPtrOutputWithContext(ctx context.Context) ProjectAuthSmtpPtrOutput {
+	return o
+}
+
+func (o ProjectAuthSmtpPtrOutput) Elem() ProjectAuthSmtpOutput {
+	return o.ApplyT(func(v *ProjectAuthSmtp) ProjectAuthSmtp {
+		if v != nil {
+			return *v
+		}
+		var ret ProjectAuthSmtp
+		return ret
+	}).(ProjectAuthSmtpOutput)
+}
+
+// Email address the auth emails are sent from
+func (o ProjectAuthSmtpPtrOutput) AdminEmail() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ProjectAuthSmtp) *string {
+		if v == nil {
+			return nil
+		}
+		return v.AdminEmail
+	}).(pulumi.StringPtrOutput)
+}
+
+// Hostname of the SMTP server
+func (o ProjectAuthSmtpPtrOutput) Host() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ProjectAuthSmtp) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Host
+	}).(pulumi.StringPtrOutput)
+}
+
+// Password of the SMTP server
+func (o ProjectAuthSmtpPtrOutput) Pass() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ProjectAuthSmtp) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Pass
+	}).(pulumi.StringPtrOutput)
+}
+
+// Port of the SMTP server
+func (o ProjectAuthSmtpPtrOutput) Port() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ProjectAuthSmtp) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Port
+	}).(pulumi.IntPtrOutput)
+}
+
+// Name the auth emails are sent from
+func (o ProjectAuthSmtpPtrOutput) SenderName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ProjectAuthSmtp) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SenderName
+	}).(pulumi.StringPtrOutput)
+}
+
+// Username of the SMTP server
+func (o ProjectAuthSmtpPtrOutput) User() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ProjectAuthSmtp) *string {
+		if v == nil {
+			return nil
+		}
+		return v.User
+	}).(pulumi.StringPtrOutput)
+}
+
+type ProjectAuthTwilio struct {
+	// Twilio account SID
+	AccountSid *string `pulumi:"accountSid"`
+	// Twilio auth token
+	AuthToken *string `pulumi:"authToken"`
+	// Twilio message service SID
+	MessageServiceSid *string `pulumi:"messageServiceSid"`
+}
+
+// ProjectAuthTwilioInput is an input type that accepts ProjectAuthTwilioArgs and ProjectAuthTwilioOutput values.
+// You can construct a concrete instance of `ProjectAuthTwilioInput` via:
+//
+//	ProjectAuthTwilioArgs{...}
+type ProjectAuthTwilioInput interface {
+	pulumi.Input
+
+	ToProjectAuthTwilioOutput() ProjectAuthTwilioOutput
+	ToProjectAuthTwilioOutputWithContext(context.Context) ProjectAuthTwilioOutput
+}
+
+type ProjectAuthTwilioArgs struct {
+	// Twilio account SID
+	AccountSid pulumi.StringPtrInput `pulumi:"accountSid"`
+	// Twilio auth token
+	AuthToken pulumi.StringPtrInput `pulumi:"authToken"`
+	// Twilio message service SID
+	MessageServiceSid pulumi.StringPtrInput `pulumi:"messageServiceSid"`
+}
+
+func (ProjectAuthTwilioArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProjectAuthTwilio)(nil)).Elem()
+}
+
+func (i ProjectAuthTwilioArgs) ToProjectAuthTwilioOutput() ProjectAuthTwilioOutput {
+	return i.ToProjectAuthTwilioOutputWithContext(context.Background())
+}
+
+func (i ProjectAuthTwilioArgs) ToProjectAuthTwilioOutputWithContext(ctx context.Context) ProjectAuthTwilioOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectAuthTwilioOutput)
+}
+
+func (i ProjectAuthTwilioArgs) ToProjectAuthTwilioPtrOutput() ProjectAuthTwilioPtrOutput {
+	return i.ToProjectAuthTwilioPtrOutputWithContext(context.Background())
+}
+
+func (i ProjectAuthTwilioArgs) ToProjectAuthTwilioPtrOutputWithContext(ctx context.Context) ProjectAuthTwilioPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectAuthTwilioOutput).ToProjectAuthTwilioPtrOutputWithContext(ctx)
+}
+
+// ProjectAuthTwilioPtrInput is an input type that accepts ProjectAuthTwilioArgs, ProjectAuthTwilioPtr and ProjectAuthTwilioPtrOutput values.
+// You can construct a concrete instance of `ProjectAuthTwilioPtrInput` via:
+//
+//	        ProjectAuthTwilioArgs{...}
+//
+//	or:
+//
+//	        nil
+type ProjectAuthTwilioPtrInput interface {
+	pulumi.Input
+
+	ToProjectAuthTwilioPtrOutput() ProjectAuthTwilioPtrOutput
+	ToProjectAuthTwilioPtrOutputWithContext(context.Context) ProjectAuthTwilioPtrOutput
+}
+
+type projectAuthTwilioPtrType ProjectAuthTwilioArgs
+
+func ProjectAuthTwilioPtr(v *ProjectAuthTwilioArgs) ProjectAuthTwilioPtrInput {
+	return (*projectAuthTwilioPtrType)(v)
+}
+
+func (*projectAuthTwilioPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ProjectAuthTwilio)(nil)).Elem()
+}
+
+func (i *projectAuthTwilioPtrType) ToProjectAuthTwilioPtrOutput() ProjectAuthTwilioPtrOutput {
+	return i.ToProjectAuthTwilioPtrOutputWithContext(context.Background())
+}
+
+func (i *projectAuthTwilioPtrType) ToProjectAuthTwilioPtrOutputWithContext(ctx context.Context) ProjectAuthTwilioPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectAuthTwilioPtrOutput)
+}
+
+type ProjectAuthTwilioOutput struct{ *pulumi.OutputState }
+
+func (ProjectAuthTwilioOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ProjectAuthTwilio)(nil)).Elem()
+}
+
+func (o ProjectAuthTwilioOutput) ToProjectAuthTwilioOutput() ProjectAuthTwilioOutput {
+	return o
+}
+
+func (o ProjectAuthTwilioOutput) ToProjectAuthTwilioOutputWithContext(ctx context.Context) ProjectAuthTwilioOutput {
+	return o
+}
+
+func (o ProjectAuthTwilioOutput) ToProjectAuthTwilioPtrOutput() ProjectAuthTwilioPtrOutput {
+	return o.ToProjectAuthTwilioPtrOutputWithContext(context.Background())
+}
+
+func (o ProjectAuthTwilioOutput) ToProjectAuthTwilioPtrOutputWithContext(ctx context.Context) ProjectAuthTwilioPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ProjectAuthTwilio) *ProjectAuthTwilio {
+		return &v
+	}).(ProjectAuthTwilioPtrOutput)
+}
+
+// Twilio account SID
+func (o ProjectAuthTwilioOutput) AccountSid() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ProjectAuthTwilio) *string { return v.AccountSid }).(pulumi.StringPtrOutput)
+}
+
+// Twilio auth token
+func (o ProjectAuthTwilioOutput) AuthToken() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ProjectAuthTwilio) *string { return v.AuthToken }).(pulumi.StringPtrOutput)
+}
+
+// Twilio message service SID
+func (o ProjectAuthTwilioOutput) MessageServiceSid() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v ProjectAuthTwilio) *string { return v.MessageServiceSid }).(pulumi.StringPtrOutput)
+}
+
+type ProjectAuthTwilioPtrOutput struct{ *pulumi.OutputState }
+
+func (ProjectAuthTwilioPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ProjectAuthTwilio)(nil)).Elem()
+}
+
+func (o ProjectAuthTwilioPtrOutput) ToProjectAuthTwilioPtrOutput() ProjectAuthTwilioPtrOutput {
+	return o
+}
+
+func (o ProjectAuthTwilioPtrOutput) ToProjectAuthTwilioPtrOutputWithContext(ctx context.Context) ProjectAuthTwilioPtrOutput {
+	return o
+}
+
+func (o ProjectAuthTwilioPtrOutput) Elem() ProjectAuthTwilioOutput {
+	return o.ApplyT(func(v *ProjectAuthTwilio) ProjectAuthTwilio {
+		if v != nil {
+			return *v
+		}
+		var ret ProjectAuthTwilio
+		return ret
+	}).(ProjectAuthTwilioOutput)
+}
+
+// Twilio account SID
+func (o ProjectAuthTwilioPtrOutput) AccountSid() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ProjectAuthTwilio) *string {
+		if v == nil {
+			return nil
+		}
+		return v.AccountSid
+	}).(pulumi.StringPtrOutput)
+}
+
+// Twilio auth token
+func (o ProjectAuthTwilioPtrOutput) AuthToken() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ProjectAuthTwilio) *string {
+		if v == nil {
+			return nil
+		}
+		return v.AuthToken
+	}).(pulumi.StringPtrOutput)
+}
+
+// Twilio message service SID
+func (o ProjectAuthTwilioPtrOutput) MessageServiceSid() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ProjectAuthTwilio) *string {
+		if v == nil {
+			return nil
+		}
+		return v.MessageServiceSid
+	}).(pulumi.StringPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*ProjectAuthEmailInput)(nil)).Elem(), ProjectAuthEmailArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ProjectAuthEmailPtrInput)(nil)).Elem(), ProjectAuthEmailArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ProjectAuthExternalProviderInput)(nil)).Elem(), ProjectAuthExternalProviderArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ProjectAuthExternalProviderMapInput)(nil)).Elem(), ProjectAuthExternalProviderMap{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ProjectAuthSmsInput)(nil)).Elem(), ProjectAuthSmsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ProjectAuthSmsPtrInput)(nil)).Elem(), ProjectAuthSmsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ProjectAuthSmtpInput)(nil)).Elem(), ProjectAuthSmtpArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ProjectAuthSmtpPtrInput)(nil)).Elem(), ProjectAuthSmtpArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ProjectAuthTwilioInput)(nil)).Elem(), ProjectAuthTwilioArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ProjectAuthTwilioPtrInput)(nil)).Elem(), ProjectAuthTwilioArgs{})
+	pulumi.RegisterOutputType(DnsRecordOutput{})
+	pulumi.RegisterOutputType(DnsRecordArrayOutput{})
+	pulumi.RegisterOutputType(ProjectAuthEmailOutput{})
+	pulumi.RegisterOutputType(ProjectAuthEmailPtrOutput{})
+	pulumi.RegisterOutputType(ProjectAuthExternalProviderOutput{})
+	pulumi.RegisterOutputType(ProjectAuthExternalProviderMapOutput{})
+	pulumi.RegisterOutputType(ProjectAuthSmsOutput{})
+	pulumi.RegisterOutputType(ProjectAuthSmsPtrOutput{})
+	pulumi.RegisterOutputType(ProjectAuthSmtpOutput{})
+	pulumi.RegisterOutputType(ProjectAuthSmtpPtrOutput{})
+	pulumi.RegisterOutputType(ProjectAuthTwilioOutput{})
+	pulumi.RegisterOutputType(ProjectAuthTwilioPtrOutput{})
+}
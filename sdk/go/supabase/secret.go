@@ -11,11 +11,19 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
+// Edge function secret of a project.
+//
+// Existing secrets can be imported with their project reference and name:
+// `pulumi import supabase:index:Secret my-secret <projectRef>/<name>`
 type Secret struct {
 	pulumi.CustomResourceState
 
+	// SHA-256 digest of the deployed value, used to detect changes made outside of Pulumi
+	Digest pulumi.StringOutput `pulumi:"digest"`
 	// Name of the secret
 	Name pulumi.StringOutput `pulumi:"name"`
+	// ID of the project
+	ProjectId pulumi.StringPtrOutput `pulumi:"projectId"`
 	// Value of the secret
 	Value pulumi.StringOutput `pulumi:"value"`
 }
@@ -40,6 +48,7 @@ func NewSecret(ctx *pulumi.Context,
 		args.Value = pulumi.ToSecret(args.Value).(pulumi.StringOutput)
 	}
 	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"digest",
 		"value",
 	})
 	opts = append(opts, secrets)
@@ -120,7 +129,7 @@ func (i *Secret) ToSecretOutputWithContext(ctx context.Context) SecretOutput {
 // SecretArrayInput is an input type that accepts SecretArray and SecretArrayOutput values.
 // You can construct a concrete instance of `SecretArrayInput` via:
 //
-//          SecretArray{ SecretArgs{...} }
+//	SecretArray{ SecretArgs{...} }
 type SecretArrayInput interface {
 	pulumi.Input
 
@@ -145,7 +154,7 @@ func (i SecretArray) ToSecretArrayOutputWithContext(ctx context.Context) SecretA
 // SecretMapInput is an input type that accepts SecretMap and SecretMapOutput values.
 // You can construct a concrete instance of `SecretMapInput` via:
 //
-//          SecretMap{ "key": SecretArgs{...} }
+//	SecretMap{ "key": SecretArgs{...} }
 type SecretMapInput interface {
 	pulumi.Input
 
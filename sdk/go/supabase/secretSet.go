@@ -0,0 +1,240 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package supabase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// All the edge function secrets of a project managed together, every change is applied in a single API call.
+//
+// Existing secrets can be adopted with the project reference: `pulumi import supabase:index:SecretSet secrets <projectRef>`,
+// their values are not readable so they are written again on the next update.
+type SecretSet struct {
+	pulumi.CustomResourceState
+
+	// SHA-256 digest of every deployed value, used to detect changes made outside of Pulumi
+	Digests pulumi.StringMapOutput `pulumi:"digests"`
+	// ID of the project
+	ProjectId pulumi.StringOutput `pulumi:"projectId"`
+	// Delete the secrets of the project missing from `secrets`
+	Prune pulumi.BoolPtrOutput `pulumi:"prune"`
+	// Secret values by name
+	Secrets pulumi.StringMapOutput `pulumi:"secrets"`
+}
+
+// NewSecretSet registers a new resource with the given unique name, arguments, and options.
+func NewSecretSet(ctx *pulumi.Context,
+	name string, args *SecretSetArgs, opts ...pulumi.ResourceOption) (*SecretSet, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.ProjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ProjectId'")
+	}
+	if args.Secrets == nil {
+		return nil, errors.New("invalid value for required argument 'Secrets'")
+	}
+	if isZero(args.Prune) {
+		args.Prune = pulumi.BoolPtr(false)
+	}
+	if args.Secrets != nil {
+		args.Secrets = pulumi.ToSecret(args.Secrets).(pulumi.StringMapOutput)
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"digests",
+		"secrets",
+	})
+	opts = append(opts, secrets)
+	opts = pkgResourceDefaultOpts(opts)
+	var resource SecretSet
+	err := ctx.RegisterResource("supabase:index:SecretSet", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetSecretSet gets an existing SecretSet resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetSecretSet(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *SecretSetState, opts ...pulumi.ResourceOption) (*SecretSet, error) {
+	var resource SecretSet
+	err := ctx.ReadResource("supabase:index:SecretSet", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering SecretSet resources.
+type secretSetState struct {
+}
+
+type SecretSetState struct {
+}
+
+func (SecretSetState) ElementType() reflect.Type {
+	return reflect.TypeOf((*secretSetState)(nil)).Elem()
+}
+
+type secretSetArgs struct {
+	// ID of the project
+	ProjectId string `pulumi:"projectId"`
+	// Delete the secrets of the project missing from `secrets` (secrets prefixed with SUPABASE_ are kept)
+	Prune *bool `pulumi:"prune"`
+	// Secret values by name
+	Secrets map[string]string `pulumi:"secrets"`
+}
+
+// The set of arguments for constructing a SecretSet resource.
+type SecretSetArgs struct {
+	// ID of the project
+	ProjectId pulumi.StringInput
+	// Delete the secrets of the project missing from `secrets` (secrets prefixed with SUPABASE_ are kept)
+	Prune pulumi.BoolPtrInput
+	// Secret values by name
+	Secrets pulumi.StringMapInput
+}
+
+func (SecretSetArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*secretSetArgs)(nil)).Elem()
+}
+
+type SecretSetInput interface {
+	pulumi.Input
+
+	ToSecretSetOutput() SecretSetOutput
+	ToSecretSetOutputWithContext(ctx context.Context) SecretSetOutput
+}
+
+func (*SecretSet) ElementType() reflect.Type {
+	return reflect.TypeOf((**SecretSet)(nil)).Elem()
+}
+
+func (i *SecretSet) ToSecretSetOutput() SecretSetOutput {
+	return i.ToSecretSetOutputWithContext(context.Background())
+}
+
+func (i *SecretSet) ToSecretSetOutputWithContext(ctx context.Context) SecretSetOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SecretSetOutput)
+}
+
+// SecretSetArrayInput is an input type that accepts SecretSetArray and SecretSetArrayOutput values.
+// You can construct a concrete instance of `SecretSetArrayInput` via:
+//
+//	SecretSetArray{ SecretSetArgs{...} }
+type SecretSetArrayInput interface {
+	pulumi.Input
+
+	ToSecretSetArrayOutput() SecretSetArrayOutput
+	ToSecretSetArrayOutputWithContext(context.Context) SecretSetArrayOutput
+}
+
+type SecretSetArray []SecretSetInput
+
+func (SecretSetArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*SecretSet)(nil)).Elem()
+}
+
+func (i SecretSetArray) ToSecretSetArrayOutput() SecretSetArrayOutput {
+	return i.ToSecretSetArrayOutputWithContext(context.Background())
+}
+
+func (i SecretSetArray) ToSecretSetArrayOutputWithContext(ctx context.Context) SecretSetArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SecretSetArrayOutput)
+}
+
+// SecretSetMapInput is an input type that accepts SecretSetMap and SecretSetMapOutput values.
+// You can construct a concrete instance of `SecretSetMapInput` via:
+//
+//	SecretSetMap{ "key": SecretSetArgs{...} }
+type SecretSetMapInput interface {
+	pulumi.Input
+
+	ToSecretSetMapOutput() SecretSetMapOutput
+	ToSecretSetMapOutputWithContext(context.Context) SecretSetMapOutput
+}
+
+type SecretSetMap map[string]SecretSetInput
+
+func (SecretSetMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*SecretSet)(nil)).Elem()
+}
+
+func (i SecretSetMap) ToSecretSetMapOutput() SecretSetMapOutput {
+	return i.ToSecretSetMapOutputWithContext(context.Background())
+}
+
+func (i SecretSetMap) ToSecretSetMapOutputWithContext(ctx context.Context) SecretSetMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SecretSetMapOutput)
+}
+
+type SecretSetOutput struct{ *pulumi.OutputState }
+
+func (SecretSetOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**SecretSet)(nil)).Elem()
+}
+
+func (o SecretSetOutput) ToSecretSetOutput() SecretSetOutput {
+	return o
+}
+
+func (o SecretSetOutput) ToSecretSetOutputWithContext(ctx context.Context) SecretSetOutput {
+	return o
+}
+
+type SecretSetArrayOutput struct{ *pulumi.OutputState }
+
+func (SecretSetArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*SecretSet)(nil)).Elem()
+}
+
+func (o SecretSetArrayOutput) ToSecretSetArrayOutput() SecretSetArrayOutput {
+	return o
+}
+
+func (o SecretSetArrayOutput) ToSecretSetArrayOutputWithContext(ctx context.Context) SecretSetArrayOutput {
+	return o
+}
+
+func (o SecretSetArrayOutput) Index(i pulumi.IntInput) SecretSetOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *SecretSet {
+		return vs[0].([]*SecretSet)[vs[1].(int)]
+	}).(SecretSetOutput)
+}
+
+type SecretSetMapOutput struct{ *pulumi.OutputState }
+
+func (SecretSetMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*SecretSet)(nil)).Elem()
+}
+
+func (o SecretSetMapOutput) ToSecretSetMapOutput() SecretSetMapOutput {
+	return o
+}
+
+func (o SecretSetMapOutput) ToSecretSetMapOutputWithContext(ctx context.Context) SecretSetMapOutput {
+	return o
+}
+
+func (o SecretSetMapOutput) MapIndex(k pulumi.StringInput) SecretSetOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *SecretSet {
+		return vs[0].(map[string]*SecretSet)[vs[1].(string)]
+	}).(SecretSetOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*SecretSetInput)(nil)).Elem(), &SecretSet{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SecretSetArrayInput)(nil)).Elem(), SecretSetArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SecretSetMapInput)(nil)).Elem(), SecretSetMap{})
+	pulumi.RegisterOutputType(SecretSetOutput{})
+	pulumi.RegisterOutputType(SecretSetArrayOutput{})
+	pulumi.RegisterOutputType(SecretSetMapOutput{})
+}
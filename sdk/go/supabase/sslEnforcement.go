@@ -0,0 +1,223 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package supabase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// SSL enforcement of the database of a project. Deleting the resource stops enforcing SSL, as on a new project.
+//
+// The enforcement of a project can be imported with its reference:
+// `pulumi import supabase:index:SslEnforcement ssl <projectRef>`
+type SslEnforcement struct {
+	pulumi.CustomResourceState
+
+	// Whether the requested enforcement was applied to the database
+	AppliedSuccessfully pulumi.BoolOutput `pulumi:"appliedSuccessfully"`
+	// Whether connections to the database must use SSL
+	Database pulumi.BoolOutput `pulumi:"database"`
+	// ID of the project
+	ProjectId pulumi.StringOutput `pulumi:"projectId"`
+}
+
+// NewSslEnforcement registers a new resource with the given unique name, arguments, and options.
+func NewSslEnforcement(ctx *pulumi.Context,
+	name string, args *SslEnforcementArgs, opts ...pulumi.ResourceOption) (*SslEnforcement, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.ProjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ProjectId'")
+	}
+	if isZero(args.Database) {
+		args.Database = pulumi.BoolPtr(true)
+	}
+	opts = pkgResourceDefaultOpts(opts)
+	var resource SslEnforcement
+	err := ctx.RegisterResource("supabase:index:SslEnforcement", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetSslEnforcement gets an existing SslEnforcement resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetSslEnforcement(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *SslEnforcementState, opts ...pulumi.ResourceOption) (*SslEnforcement, error) {
+	var resource SslEnforcement
+	err := ctx.ReadResource("supabase:index:SslEnforcement", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering SslEnforcement resources.
+type sslEnforcementState struct {
+}
+
+type SslEnforcementState struct {
+}
+
+func (SslEnforcementState) ElementType() reflect.Type {
+	return reflect.TypeOf((*sslEnforcementState)(nil)).Elem()
+}
+
+type sslEnforcementArgs struct {
+	// Whether connections to the database must use SSL
+	Database *bool `pulumi:"database"`
+	// ID of the project
+	ProjectId string `pulumi:"projectId"`
+}
+
+// The set of arguments for constructing a SslEnforcement resource.
+type SslEnforcementArgs struct {
+	// Whether connections to the database must use SSL
+	Database pulumi.BoolPtrInput
+	// ID of the project
+	ProjectId pulumi.StringInput
+}
+
+func (SslEnforcementArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*sslEnforcementArgs)(nil)).Elem()
+}
+
+type SslEnforcementInput interface {
+	pulumi.Input
+
+	ToSslEnforcementOutput() SslEnforcementOutput
+	ToSslEnforcementOutputWithContext(ctx context.Context) SslEnforcementOutput
+}
+
+func (*SslEnforcement) ElementType() reflect.Type {
+	return reflect.TypeOf((**SslEnforcement)(nil)).Elem()
+}
+
+func (i *SslEnforcement) ToSslEnforcementOutput() SslEnforcementOutput {
+	return i.ToSslEnforcementOutputWithContext(context.Background())
+}
+
+func (i *SslEnforcement) ToSslEnforcementOutputWithContext(ctx context.Context) SslEnforcementOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SslEnforcementOutput)
+}
+
+// SslEnforcementArrayInput is an input type that accepts SslEnforcementArray and SslEnforcementArrayOutput values.
+// You can construct a concrete instance of `SslEnforcementArrayInput` via:
+//
+//	SslEnforcementArray{ SslEnforcementArgs{...} }
+type SslEnforcementArrayInput interface {
+	pulumi.Input
+
+	ToSslEnforcementArrayOutput() SslEnforcementArrayOutput
+	ToSslEnforcementArrayOutputWithContext(context.Context) SslEnforcementArrayOutput
+}
+
+type SslEnforcementArray []SslEnforcementInput
+
+func (SslEnforcementArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*SslEnforcement)(nil)).Elem()
+}
+
+func (i SslEnforcementArray) ToSslEnforcementArrayOutput() SslEnforcementArrayOutput {
+	return i.ToSslEnforcementArrayOutputWithContext(context.Background())
+}
+
+func (i SslEnforcementArray) ToSslEnforcementArrayOutputWithContext(ctx context.Context) SslEnforcementArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SslEnforcementArrayOutput)
+}
+
+// SslEnforcementMapInput is an input type that accepts SslEnforcementMap and SslEnforcementMapOutput values.
+// You can construct a concrete instance of `SslEnforcementMapInput` via:
+//
+//	SslEnforcementMap{ "key": SslEnforcementArgs{...} }
+type SslEnforcementMapInput interface {
+	pulumi.Input
+
+	ToSslEnforcementMapOutput() SslEnforcementMapOutput
+	ToSslEnforcementMapOutputWithContext(context.Context) SslEnforcementMapOutput
+}
+
+type SslEnforcementMap map[string]SslEnforcementInput
+
+func (SslEnforcementMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*SslEnforcement)(nil)).Elem()
+}
+
+func (i SslEnforcementMap) ToSslEnforcementMapOutput() SslEnforcementMapOutput {
+	return i.ToSslEnforcementMapOutputWithContext(context.Background())
+}
+
+func (i SslEnforcementMap) ToSslEnforcementMapOutputWithContext(ctx context.Context) SslEnforcementMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SslEnforcementMapOutput)
+}
+
+type SslEnforcementOutput struct{ *pulumi.OutputState }
+
+func (SslEnforcementOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**SslEnforcement)(nil)).Elem()
+}
+
+func (o SslEnforcementOutput) ToSslEnforcementOutput() SslEnforcementOutput {
+	return o
+}
+
+func (o SslEnforcementOutput) ToSslEnforcementOutputWithContext(ctx context.Context) SslEnforcementOutput {
+	return o
+}
+
+type SslEnforcementArrayOutput struct{ *pulumi.OutputState }
+
+func (SslEnforcementArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*SslEnforcement)(nil)).Elem()
+}
+
+func (o SslEnforcementArrayOutput) ToSslEnforcementArrayOutput() SslEnforcementArrayOutput {
+	return o
+}
+
+func (o SslEnforcementArrayOutput) ToSslEnforcementArrayOutputWithContext(ctx context.Context) SslEnforcementArrayOutput {
+	return o
+}
+
+func (o SslEnforcementArrayOutput) Index(i pulumi.IntInput) SslEnforcementOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *SslEnforcement {
+		return vs[0].([]*SslEnforcement)[vs[1].(int)]
+	}).(SslEnforcementOutput)
+}
+
+type SslEnforcementMapOutput struct{ *pulumi.OutputState }
+
+func (SslEnforcementMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*SslEnforcement)(nil)).Elem()
+}
+
+func (o SslEnforcementMapOutput) ToSslEnforcementMapOutput() SslEnforcementMapOutput {
+	return o
+}
+
+func (o SslEnforcementMapOutput) ToSslEnforcementMapOutputWithContext(ctx context.Context) SslEnforcementMapOutput {
+	return o
+}
+
+func (o SslEnforcementMapOutput) MapIndex(k pulumi.StringInput) SslEnforcementOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *SslEnforcement {
+		return vs[0].(map[string]*SslEnforcement)[vs[1].(string)]
+	}).(SslEnforcementOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*SslEnforcementInput)(nil)).Elem(), &SslEnforcement{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SslEnforcementArrayInput)(nil)).Elem(), SslEnforcementArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SslEnforcementMapInput)(nil)).Elem(), SslEnforcementMap{})
+	pulumi.RegisterOutputType(SslEnforcementOutput{})
+	pulumi.RegisterOutputType(SslEnforcementArrayOutput{})
+	pulumi.RegisterOutputType(SslEnforcementMapOutput{})
+}
@@ -0,0 +1,226 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package supabase
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Vanity subdomain of a project. Its availability is checked during the preview, and deleting the resource
+// removes the subdomain.
+//
+// The vanity subdomain of a project can be imported with its reference:
+// `pulumi import supabase:index:VanitySubdomain subdomain <projectRef>`
+type VanitySubdomain struct {
+	pulumi.CustomResourceState
+
+	// Domain serving the project, <subdomain>.supabase.co
+	CustomDomain pulumi.StringOutput `pulumi:"customDomain"`
+	// ID of the project
+	ProjectId pulumi.StringOutput `pulumi:"projectId"`
+	// Status of the subdomain (active, custom-domain-used or not-used)
+	Status pulumi.StringOutput `pulumi:"status"`
+	// Vanity subdomain of the project, served as <subdomain>.supabase.co
+	Subdomain pulumi.StringOutput `pulumi:"subdomain"`
+}
+
+// NewVanitySubdomain registers a new resource with the given unique name, arguments, and options.
+func NewVanitySubdomain(ctx *pulumi.Context,
+	name string, args *VanitySubdomainArgs, opts ...pulumi.ResourceOption) (*VanitySubdomain, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.ProjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ProjectId'")
+	}
+	if args.Subdomain == nil {
+		return nil, errors.New("invalid value for required argument 'Subdomain'")
+	}
+	opts = pkgResourceDefaultOpts(opts)
+	var resource VanitySubdomain
+	err := ctx.RegisterResource("supabase:index:VanitySubdomain", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetVanitySubdomain gets an existing VanitySubdomain resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetVanitySubdomain(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *VanitySubdomainState, opts ...pulumi.ResourceOption) (*VanitySubdomain, error) {
+	var resource VanitySubdomain
+	err := ctx.ReadResource("supabase:index:VanitySubdomain", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering VanitySubdomain resources.
+type vanitySubdomainState struct {
+}
+
+type VanitySubdomainState struct {
+}
+
+func (VanitySubdomainState) ElementType() reflect.Type {
+	return reflect.TypeOf((*vanitySubdomainState)(nil)).Elem()
+}
+
+type vanitySubdomainArgs struct {
+	// ID of the project
+	ProjectId string `pulumi:"projectId"`
+	// Vanity subdomain of the project, served as <subdomain>.supabase.co
+	Subdomain string `pulumi:"subdomain"`
+}
+
+// The set of arguments for constructing a VanitySubdomain resource.
+type VanitySubdomainArgs struct {
+	// ID of the project
+	ProjectId pulumi.StringInput
+	// Vanity subdomain of the project, served as <subdomain>.supabase.co
+	Subdomain pulumi.StringInput
+}
+
+func (VanitySubdomainArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*vanitySubdomainArgs)(nil)).Elem()
+}
+
+type VanitySubdomainInput interface {
+	pulumi.Input
+
+	ToVanitySubdomainOutput() VanitySubdomainOutput
+	ToVanitySubdomainOutputWithContext(ctx context.Context) VanitySubdomainOutput
+}
+
+func (*VanitySubdomain) ElementType() reflect.Type {
+	return reflect.TypeOf((**VanitySubdomain)(nil)).Elem()
+}
+
+func (i *VanitySubdomain) ToVanitySubdomainOutput() VanitySubdomainOutput {
+	return i.ToVanitySubdomainOutputWithContext(context.Background())
+}
+
+func (i *VanitySubdomain) ToVanitySubdomainOutputWithContext(ctx context.Context) VanitySubdomainOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(VanitySubdomainOutput)
+}
+
+// VanitySubdomainArrayInput is an input type that accepts VanitySubdomainArray and VanitySubdomainArrayOutput values.
+// You can construct a concrete instance of `VanitySubdomainArrayInput` via:
+//
+//	VanitySubdomainArray{ VanitySubdomainArgs{...} }
+type VanitySubdomainArrayInput interface {
+	pulumi.Input
+
+	ToVanitySubdomainArrayOutput() VanitySubdomainArrayOutput
+	ToVanitySubdomainArrayOutputWithContext(context.Context) VanitySubdomainArrayOutput
+}
+
+type VanitySubdomainArray []VanitySubdomainInput
+
+func (VanitySubdomainArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*VanitySubdomain)(nil)).Elem()
+}
+
+func (i VanitySubdomainArray) ToVanitySubdomainArrayOutput() VanitySubdomainArrayOutput {
+	return i.ToVanitySubdomainArrayOutputWithContext(context.Background())
+}
+
+func (i VanitySubdomainArray) ToVanitySubdomainArrayOutputWithContext(ctx context.Context) VanitySubdomainArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(VanitySubdomainArrayOutput)
+}
+
+// VanitySubdomainMapInput is an input type that accepts VanitySubdomainMap and VanitySubdomainMapOutput values.
+// You can construct a concrete instance of `VanitySubdomainMapInput` via:
+//
+//	VanitySubdomainMap{ "key": VanitySubdomainArgs{...} }
+type VanitySubdomainMapInput interface {
+	pulumi.Input
+
+	ToVanitySubdomainMapOutput() VanitySubdomainMapOutput
+	ToVanitySubdomainMapOutputWithContext(context.Context) VanitySubdomainMapOutput
+}
+
+type VanitySubdomainMap map[string]VanitySubdomainInput
+
+func (VanitySubdomainMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*VanitySubdomain)(nil)).Elem()
+}
+
+func (i VanitySubdomainMap) ToVanitySubdomainMapOutput() VanitySubdomainMapOutput {
+	return i.ToVanitySubdomainMapOutputWithContext(context.Background())
+}
+
+func (i VanitySubdomainMap) ToVanitySubdomainMapOutputWithContext(ctx context.Context) VanitySubdomainMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(VanitySubdomainMapOutput)
+}
+
+type VanitySubdomainOutput struct{ *pulumi.OutputState }
+
+func (VanitySubdomainOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**VanitySubdomain)(nil)).Elem()
+}
+
+func (o VanitySubdomainOutput) ToVanitySubdomainOutput() VanitySubdomainOutput {
+	return o
+}
+
+func (o VanitySubdomainOutput) ToVanitySubdomainOutputWithContext(ctx context.Context) VanitySubdomainOutput {
+	return o
+}
+
+type VanitySubdomainArrayOutput struct{ *pulumi.OutputState }
+
+func (VanitySubdomainArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*VanitySubdomain)(nil)).Elem()
+}
+
+func (o VanitySubdomainArrayOutput) ToVanitySubdomainArrayOutput() VanitySubdomainArrayOutput {
+	return o
+}
+
+func (o VanitySubdomainArrayOutput) ToVanitySubdomainArrayOutputWithContext(ctx context.Context) VanitySubdomainArrayOutput {
+	return o
+}
+
+func (o VanitySubdomainArrayOutput) Index(i pulumi.IntInput) VanitySubdomainOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *VanitySubdomain {
+		return vs[0].([]*VanitySubdomain)[vs[1].(int)]
+	}).(VanitySubdomainOutput)
+}
+
+type VanitySubdomainMapOutput struct{ *pulumi.OutputState }
+
+func (VanitySubdomainMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*VanitySubdomain)(nil)).Elem()
+}
+
+func (o VanitySubdomainMapOutput) ToVanitySubdomainMapOutput() VanitySubdomainMapOutput {
+	return o
+}
+
+func (o VanitySubdomainMapOutput) ToVanitySubdomainMapOutputWithContext(ctx context.Context) VanitySubdomainMapOutput {
+	return o
+}
+
+func (o VanitySubdomainMapOutput) MapIndex(k pulumi.StringInput) VanitySubdomainOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *VanitySubdomain {
+		return vs[0].(map[string]*VanitySubdomain)[vs[1].(string)]
+	}).(VanitySubdomainOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*VanitySubdomainInput)(nil)).Elem(), &VanitySubdomain{})
+	pulumi.RegisterInputType(reflect.TypeOf((*VanitySubdomainArrayInput)(nil)).Elem(), VanitySubdomainArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*VanitySubdomainMapInput)(nil)).Elem(), VanitySubdomainMap{})
+	pulumi.RegisterOutputType(VanitySubdomainOutput{})
+	pulumi.RegisterOutputType(VanitySubdomainArrayOutput{})
+	pulumi.RegisterOutputType(VanitySubdomainMapOutput{})
+}
@@ -2,11 +2,55 @@
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
-import * as utilities from "../utilities";
+import * as utilities from "./utilities";
 
 declare var exports: any;
 const __config = new pulumi.Config("supabase");
 
+/**
+ * Client side limit of Management API requests per second shared by all resource operations, 0 disables it
+ */
+export declare const maxRequestsPerSecond: number;
+Object.defineProperty(exports, "maxRequestsPerSecond", {
+    get() {
+        return __config.getObject<number>("maxRequestsPerSecond") ?? 1;
+    },
+    enumerable: true,
+});
+
+/**
+ * Maximum number of retries for rate limited (429) or transient Management API failures
+ */
+export declare const maxRetries: number;
+Object.defineProperty(exports, "maxRetries", {
+    get() {
+        return __config.getObject<number>("maxRetries") ?? 3;
+    },
+    enumerable: true,
+});
+
+/**
+ * Initial delay in seconds between retries, doubled on every attempt with jitter
+ */
+export declare const retryBaseDelay: number;
+Object.defineProperty(exports, "retryBaseDelay", {
+    get() {
+        return __config.getObject<number>("retryBaseDelay") ?? 1;
+    },
+    enumerable: true,
+});
+
+/**
+ * Maximum delay in seconds between retries, a longer Retry-After from the API is capped to it
+ */
+export declare const retryMaxDelay: number;
+Object.defineProperty(exports, "retryMaxDelay", {
+    get() {
+        return __config.getObject<number>("retryMaxDelay") ?? 30;
+    },
+    enumerable: true,
+});
+
 /**
  * Supabase server (https://api.supabase.com/)
  */
@@ -0,0 +1,116 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
+import * as utilities from "./utilities";
+
+/**
+ * Custom hostname of a project. The `dnsRecords` output lists the CNAME and TXT records to create at the DNS provider
+ * of the hostname. Once they exist, set `waitForVerification` to wait for the hostname to be verified and activate it.
+ * Do not set it on creation when the DNS records depend on this resource, the verification would never succeed.
+ * Deleting the resource removes the custom hostname.
+ *
+ * The custom hostname of a project can be imported with its reference:
+ * `pulumi import supabase:index:CustomHostname api <projectRef>`
+ */
+export class CustomHostname extends pulumi.CustomResource {
+    /**
+     * Get an existing CustomHostname resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): CustomHostname {
+        return new CustomHostname(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'supabase:index:CustomHostname';
+
+    /**
+     * Returns true if the given object is an instance of CustomHostname.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is CustomHostname {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === CustomHostname.__pulumiType;
+    }
+
+    /**
+     * Whether the hostname is verified and serves the project
+     */
+    public /*out*/ readonly activated!: pulumi.Output<boolean>;
+    /**
+     * DNS records to create for the hostname to be verified
+     */
+    public /*out*/ readonly dnsRecords!: pulumi.Output<outputs.DnsRecord[]>;
+    /**
+     * Custom hostname of the project, such as api.example.com
+     */
+    public readonly hostname!: pulumi.Output<string>;
+    /**
+     * ID of the project
+     */
+    public readonly projectId!: pulumi.Output<string>;
+    /**
+     * Verification status of the hostname (1_not_started to 5_services_reconfigured)
+     */
+    public /*out*/ readonly status!: pulumi.Output<string>;
+
+    /**
+     * Create a CustomHostname resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: CustomHostnameArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.hostname === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'hostname'");
+            }
+            if ((!args || args.projectId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'projectId'");
+            }
+            resourceInputs["hostname"] = args ? args.hostname : undefined;
+            resourceInputs["projectId"] = args ? args.projectId : undefined;
+            resourceInputs["waitForVerification"] = (args ? args.waitForVerification : undefined) ?? false;
+            resourceInputs["activated"] = undefined /*out*/;
+            resourceInputs["dnsRecords"] = undefined /*out*/;
+            resourceInputs["status"] = undefined /*out*/;
+        } else {
+            resourceInputs["activated"] = undefined /*out*/;
+            resourceInputs["dnsRecords"] = undefined /*out*/;
+            resourceInputs["hostname"] = undefined /*out*/;
+            resourceInputs["projectId"] = undefined /*out*/;
+            resourceInputs["status"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(CustomHostname.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a CustomHostname resource.
+ */
+export interface CustomHostnameArgs {
+    /**
+     * Custom hostname of the project, such as api.example.com
+     */
+    hostname: pulumi.Input<string>;
+    /**
+     * ID of the project
+     */
+    projectId: pulumi.Input<string>;
+    /**
+     * Whether to wait for the DNS records to be verified and then activate the hostname
+     */
+    waitForVerification?: pulumi.Input<boolean>;
+}
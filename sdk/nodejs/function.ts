@@ -5,6 +5,12 @@ import * as pulumi from "@pulumi/pulumi";
 import { input as inputs, output as outputs, enums } from "./types";
 import * as utilities from "./utilities";
 
+/**
+ * Edge function of a project.
+ *
+ * Existing functions can be imported with their project reference and slug:
+ * `pulumi import supabase:index:Function my-function <projectRef>/<slug>`
+ */
 export class Function extends pulumi.CustomResource {
     /**
      * Get an existing Function resource's state with the given name, ID, and optional extra
@@ -32,18 +38,46 @@ export class Function extends pulumi.CustomResource {
         return obj['__pulumiType'] === Function.__pulumiType;
     }
 
+    /**
+     * SHA-256 of the deployed body, stored in place of the body itself
+     */
+    public /*out*/ readonly bodyHash!: pulumi.Output<string | undefined>;
     /**
      * Function creation date
      */
     public /*out*/ readonly created_at!: pulumi.Output<string>;
+    /**
+     * Entrypoint of the function
+     */
+    public readonly entrypointPath!: pulumi.Output<string | undefined>;
+    /**
+     * Whether the function is deployed with an import map
+     */
+    public /*out*/ readonly hasImportMap!: pulumi.Output<boolean | undefined>;
+    /**
+     * Import map of the function, inline or as the path to its file
+     */
+    public readonly importMap!: pulumi.Output<string | undefined>;
+    /**
+     * Path the import map is deployed to
+     */
+    public readonly importMapPath!: pulumi.Output<string | undefined>;
     /**
      * Name of the function
      */
     public readonly name!: pulumi.Output<string>;
+    /**
+     * ID of the project
+     */
+    public readonly projectId!: pulumi.Output<string | undefined>;
     /**
      * Slug of the function
      */
     public readonly slug!: pulumi.Output<string>;
+    /**
+     * SHA-256 of the deployed source bundle
+     */
+    public /*out*/ readonly sourceHash!: pulumi.Output<string | undefined>;
     /**
      * Status of the function
      */
@@ -72,9 +106,6 @@ export class Function extends pulumi.CustomResource {
         let resourceInputs: pulumi.Inputs = {};
         opts = opts || {};
         if (!opts.id) {
-            if ((!args || args.body === undefined) && !opts.urn) {
-                throw new Error("Missing required property 'body'");
-            }
             if ((!args || args.name === undefined) && !opts.urn) {
                 throw new Error("Missing required property 'name'");
             }
@@ -85,18 +116,32 @@ export class Function extends pulumi.CustomResource {
                 throw new Error("Missing required property 'slug'");
             }
             resourceInputs["body"] = args?.body ? pulumi.secret(args.body) : undefined;
+            resourceInputs["entrypointPath"] = args ? args.entrypointPath : undefined;
+            resourceInputs["importMap"] = args ? args.importMap : undefined;
+            resourceInputs["importMapPath"] = args ? args.importMapPath : undefined;
             resourceInputs["name"] = args ? args.name : undefined;
             resourceInputs["projectId"] = args ? args.projectId : undefined;
             resourceInputs["slug"] = args ? args.slug : undefined;
+            resourceInputs["source"] = args ? args.source : undefined;
             resourceInputs["verify_jwt"] = (args ? args.verify_jwt : undefined) ?? false;
+            resourceInputs["bodyHash"] = undefined /*out*/;
             resourceInputs["created_at"] = undefined /*out*/;
+            resourceInputs["hasImportMap"] = undefined /*out*/;
+            resourceInputs["sourceHash"] = undefined /*out*/;
             resourceInputs["status"] = undefined /*out*/;
             resourceInputs["updatedAt"] = undefined /*out*/;
             resourceInputs["version"] = undefined /*out*/;
         } else {
+            resourceInputs["bodyHash"] = undefined /*out*/;
             resourceInputs["created_at"] = undefined /*out*/;
+            resourceInputs["entrypointPath"] = undefined /*out*/;
+            resourceInputs["hasImportMap"] = undefined /*out*/;
+            resourceInputs["importMap"] = undefined /*out*/;
+            resourceInputs["importMapPath"] = undefined /*out*/;
             resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["projectId"] = undefined /*out*/;
             resourceInputs["slug"] = undefined /*out*/;
+            resourceInputs["sourceHash"] = undefined /*out*/;
             resourceInputs["status"] = undefined /*out*/;
             resourceInputs["updatedAt"] = undefined /*out*/;
             resourceInputs["verify_jwt"] = undefined /*out*/;
@@ -114,7 +159,19 @@ export interface FunctionArgs {
     /**
      * Body of the functino
      */
-    body: pulumi.Input<string>;
+    body?: pulumi.Input<string>;
+    /**
+     * Entrypoint of the function relative to its source directory (index.ts by default), or the file name body is deployed as
+     */
+    entrypointPath?: pulumi.Input<string>;
+    /**
+     * Import map of the function, given inline as JSON or as the path to its file. It replaces the import_map.json or deno.json found next to the entrypoint
+     */
+    importMap?: pulumi.Input<string>;
+    /**
+     * Path the import map is deployed to, relative to the entrypoint directory. Its relative mappings are rewritten to point to the same modules. By default an import map file keeps its place relative to the modules
+     */
+    importMapPath?: pulumi.Input<string>;
     /**
      * Name of the function
      */
@@ -127,6 +184,10 @@ export interface FunctionArgs {
      * Slug of the function
      */
     slug: pulumi.Input<string>;
+    /**
+     * Local directory (with an index.ts entrypoint) or entrypoint file of the function, its local imports and import map are bundled and deployed in place of body
+     */
+    source?: pulumi.Input<string>;
     /**
      * Verify JWT before running
      */
@@ -0,0 +1,48 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Lists the IPv4 addresses banned by the database of a project
+ */
+export function getNetworkBans(args: GetNetworkBansArgs, opts?: pulumi.InvokeOptions): Promise<GetNetworkBansResult> {
+    if (!opts) {
+        opts = {}
+    }
+
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+    return pulumi.runtime.invoke("supabase:index:getNetworkBans", {
+        "projectId": args.projectId,
+    }, opts);
+}
+
+export interface GetNetworkBansArgs {
+    /**
+     * ID of the project
+     */
+    projectId: string;
+}
+
+export interface GetNetworkBansResult {
+    /**
+     * Banned IPv4 addresses
+     */
+    readonly bannedIpv4Addresses: string[];
+    /**
+     * ID of the project
+     */
+    readonly projectId: string;
+}
+
+export function getNetworkBansOutput(args: GetNetworkBansOutputArgs, opts?: pulumi.InvokeOptions): pulumi.Output<GetNetworkBansResult> {
+    return pulumi.output(args).apply(a => getNetworkBans(a, opts))
+}
+
+export interface GetNetworkBansOutputArgs {
+    /**
+     * ID of the project
+     */
+    projectId: pulumi.Input<string>;
+}
@@ -5,41 +5,76 @@ import * as pulumi from "@pulumi/pulumi";
 import * as utilities from "./utilities";
 
 // Export members:
+export * from "./customHostname";
 export * from "./function";
+export * from "./getNetworkBans";
 export * from "./getTypeScript";
+export * from "./networkBanRemoval";
+export * from "./networkRestrictions";
 export * from "./organization";
+export * from "./postgrestConfig";
 export * from "./project";
+export * from "./projectAuthConfig";
 export * from "./provider";
 export * from "./secret";
+export * from "./secretSet";
+export * from "./sslEnforcement";
+export * from "./vanitySubdomain";
 
 // Export enums:
 export * from "./types/enums";
 
 // Export sub-modules:
 import * as config from "./config";
+import * as types from "./types";
 
 export {
     config,
+    types,
 };
 
 // Import resources to register:
+import { CustomHostname } from "./customHostname";
 import { Function } from "./function";
+import { NetworkBanRemoval } from "./networkBanRemoval";
+import { NetworkRestrictions } from "./networkRestrictions";
 import { Organization } from "./organization";
+import { PostgrestConfig } from "./postgrestConfig";
 import { Project } from "./project";
+import { ProjectAuthConfig } from "./projectAuthConfig";
 import { Secret } from "./secret";
+import { SecretSet } from "./secretSet";
+import { SslEnforcement } from "./sslEnforcement";
+import { VanitySubdomain } from "./vanitySubdomain";
 
 const _module = {
     version: utilities.getVersion(),
     construct: (name: string, type: string, urn: string): pulumi.Resource => {
         switch (type) {
+            case "supabase:index:CustomHostname":
+                return new CustomHostname(name, <any>undefined, { urn })
             case "supabase:index:Function":
                 return new Function(name, <any>undefined, { urn })
+            case "supabase:index:NetworkBanRemoval":
+                return new NetworkBanRemoval(name, <any>undefined, { urn })
+            case "supabase:index:NetworkRestrictions":
+                return new NetworkRestrictions(name, <any>undefined, { urn })
             case "supabase:index:Organization":
                 return new Organization(name, <any>undefined, { urn })
+            case "supabase:index:PostgrestConfig":
+                return new PostgrestConfig(name, <any>undefined, { urn })
             case "supabase:index:Project":
                 return new Project(name, <any>undefined, { urn })
+            case "supabase:index:ProjectAuthConfig":
+                return new ProjectAuthConfig(name, <any>undefined, { urn })
             case "supabase:index:Secret":
                 return new Secret(name, <any>undefined, { urn })
+            case "supabase:index:SecretSet":
+                return new SecretSet(name, <any>undefined, { urn })
+            case "supabase:index:SslEnforcement":
+                return new SslEnforcement(name, <any>undefined, { urn })
+            case "supabase:index:VanitySubdomain":
+                return new VanitySubdomain(name, <any>undefined, { urn })
             default:
                 throw new Error(`unknown resource type ${type}`);
         }
@@ -0,0 +1,104 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Lifts the network bans of a set of IPv4 addresses of a project, the database bans addresses after repeated
+ * failed connections. The bans are lifted on creation and again whenever the addresses or the triggers change,
+ * deleting the resource does not ban the addresses again.
+ */
+export class NetworkBanRemoval extends pulumi.CustomResource {
+    /**
+     * Get an existing NetworkBanRemoval resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): NetworkBanRemoval {
+        return new NetworkBanRemoval(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'supabase:index:NetworkBanRemoval';
+
+    /**
+     * Returns true if the given object is an instance of NetworkBanRemoval.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is NetworkBanRemoval {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === NetworkBanRemoval.__pulumiType;
+    }
+
+    /**
+     * IPv4 addresses whose bans are lifted
+     */
+    public readonly ipv4Addresses!: pulumi.Output<string[]>;
+    /**
+     * ID of the project
+     */
+    public readonly projectId!: pulumi.Output<string>;
+    /**
+     * Values which lift the bans again whenever they change, such as a deployment ID
+     */
+    public readonly triggers!: pulumi.Output<any[] | undefined>;
+    /**
+     * Addresses which were banned when the bans were last lifted
+     */
+    public /*out*/ readonly unbannedIpv4Addresses!: pulumi.Output<string[]>;
+
+    /**
+     * Create a NetworkBanRemoval resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: NetworkBanRemovalArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.ipv4Addresses === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'ipv4Addresses'");
+            }
+            if ((!args || args.projectId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'projectId'");
+            }
+            resourceInputs["ipv4Addresses"] = args ? args.ipv4Addresses : undefined;
+            resourceInputs["projectId"] = args ? args.projectId : undefined;
+            resourceInputs["triggers"] = args ? args.triggers : undefined;
+            resourceInputs["unbannedIpv4Addresses"] = undefined /*out*/;
+        } else {
+            resourceInputs["ipv4Addresses"] = undefined /*out*/;
+            resourceInputs["projectId"] = undefined /*out*/;
+            resourceInputs["triggers"] = undefined /*out*/;
+            resourceInputs["unbannedIpv4Addresses"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(NetworkBanRemoval.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a NetworkBanRemoval resource.
+ */
+export interface NetworkBanRemovalArgs {
+    /**
+     * IPv4 addresses whose bans are lifted
+     */
+    ipv4Addresses: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * ID of the project
+     */
+    projectId: pulumi.Input<string>;
+    /**
+     * Values which lift the bans again whenever they change, such as a deployment ID
+     */
+    triggers?: pulumi.Input<any[]>;
+}
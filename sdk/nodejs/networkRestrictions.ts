@@ -0,0 +1,101 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Network restrictions of the database of a project. Deleting the resource allows every address again.
+ *
+ * The restrictions of a project can be imported with its reference:
+ * `pulumi import supabase:index:NetworkRestrictions db <projectRef>`
+ */
+export class NetworkRestrictions extends pulumi.CustomResource {
+    /**
+     * Get an existing NetworkRestrictions resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): NetworkRestrictions {
+        return new NetworkRestrictions(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'supabase:index:NetworkRestrictions';
+
+    /**
+     * Returns true if the given object is an instance of NetworkRestrictions.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is NetworkRestrictions {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === NetworkRestrictions.__pulumiType;
+    }
+
+    /**
+     * IPv4 and IPv6 CIDRs allowed to connect to the database, they must not overlap
+     */
+    public readonly dbAllowedCidrs!: pulumi.Output<string[]>;
+    /**
+     * Whether the plan of the project allows network restrictions (allowed or disallowed)
+     */
+    public /*out*/ readonly entitlement!: pulumi.Output<string>;
+    /**
+     * ID of the project
+     */
+    public readonly projectId!: pulumi.Output<string>;
+    /**
+     * Whether the restrictions are applied or only stored
+     */
+    public /*out*/ readonly status!: pulumi.Output<string>;
+
+    /**
+     * Create a NetworkRestrictions resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: NetworkRestrictionsArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.dbAllowedCidrs === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'dbAllowedCidrs'");
+            }
+            if ((!args || args.projectId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'projectId'");
+            }
+            resourceInputs["dbAllowedCidrs"] = args ? args.dbAllowedCidrs : undefined;
+            resourceInputs["projectId"] = args ? args.projectId : undefined;
+            resourceInputs["entitlement"] = undefined /*out*/;
+            resourceInputs["status"] = undefined /*out*/;
+        } else {
+            resourceInputs["dbAllowedCidrs"] = undefined /*out*/;
+            resourceInputs["entitlement"] = undefined /*out*/;
+            resourceInputs["projectId"] = undefined /*out*/;
+            resourceInputs["status"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(NetworkRestrictions.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a NetworkRestrictions resource.
+ */
+export interface NetworkRestrictionsArgs {
+    /**
+     * IPv4 and IPv6 CIDRs allowed to connect to the database, they must not overlap
+     */
+    dbAllowedCidrs: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * ID of the project
+     */
+    projectId: pulumi.Input<string>;
+}
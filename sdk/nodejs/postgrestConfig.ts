@@ -0,0 +1,107 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * PostgREST (Data API) configuration of a project. Only the settings given are managed, the others are left as is,
+ * and deleting the resource restores the settings of a new project.
+ *
+ * The configuration of a project can be imported with its reference:
+ * `pulumi import supabase:index:PostgrestConfig api <projectRef>`
+ */
+export class PostgrestConfig extends pulumi.CustomResource {
+    /**
+     * Get an existing PostgrestConfig resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): PostgrestConfig {
+        return new PostgrestConfig(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'supabase:index:PostgrestConfig';
+
+    /**
+     * Returns true if the given object is an instance of PostgrestConfig.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is PostgrestConfig {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === PostgrestConfig.__pulumiType;
+    }
+
+    /**
+     * Schemas added to the search path of every request (public and extensions by default)
+     */
+    public readonly dbExtraSearchPath!: pulumi.Output<string[] | undefined>;
+    /**
+     * Schemas exposed by the API (public, storage and graphql_public by default)
+     */
+    public readonly dbSchema!: pulumi.Output<string[] | undefined>;
+    /**
+     * Maximum number of rows returned by a request (1000 by default)
+     */
+    public readonly maxRows!: pulumi.Output<number | undefined>;
+    /**
+     * ID of the project
+     */
+    public readonly projectId!: pulumi.Output<string>;
+
+    /**
+     * Create a PostgrestConfig resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: PostgrestConfigArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.projectId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'projectId'");
+            }
+            resourceInputs["dbExtraSearchPath"] = args ? args.dbExtraSearchPath : undefined;
+            resourceInputs["dbSchema"] = args ? args.dbSchema : undefined;
+            resourceInputs["maxRows"] = args ? args.maxRows : undefined;
+            resourceInputs["projectId"] = args ? args.projectId : undefined;
+        } else {
+            resourceInputs["dbExtraSearchPath"] = undefined /*out*/;
+            resourceInputs["dbSchema"] = undefined /*out*/;
+            resourceInputs["maxRows"] = undefined /*out*/;
+            resourceInputs["projectId"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(PostgrestConfig.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a PostgrestConfig resource.
+ */
+export interface PostgrestConfigArgs {
+    /**
+     * Schemas added to the search path of every request (public and extensions by default)
+     */
+    dbExtraSearchPath?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Schemas exposed by the API (public, storage and graphql_public by default)
+     */
+    dbSchema?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Maximum number of rows returned by a request (1000 by default)
+     */
+    maxRows?: pulumi.Input<number>;
+    /**
+     * ID of the project
+     */
+    projectId: pulumi.Input<string>;
+}
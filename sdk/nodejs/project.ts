@@ -5,6 +5,16 @@ import * as pulumi from "@pulumi/pulumi";
 import { input as inputs, output as outputs, enums } from "./types";
 import * as utilities from "./utilities";
 
+/**
+ * Supabase project.
+ *
+ * Existing projects can be imported with their reference:
+ * `pulumi import supabase:index:Project my-project <projectRef>`
+ * The API does not return the database password and KPS setting of a project, and only some versions of it return
+ * its plan. After an import, the first update records the plan and KPS setting of the program without changing the
+ * project. A plan change fails the update when the plan read back from the API is not the new one. The password is only
+ * recorded along with another update, until then changing it does not replace the project.
+ */
 export class Project extends pulumi.CustomResource {
     /**
      * Get an existing Project resource's state with the given name, ID, and optional extra
@@ -56,10 +66,18 @@ export class Project extends pulumi.CustomResource {
      * DB Username
      */
     public /*out*/ readonly dbUsername!: pulumi.Output<string>;
+    /**
+     * Refuse to delete the project while enabled
+     */
+    public readonly deletionProtection!: pulumi.Output<boolean | undefined>;
     /**
      * Supabase endpoint for client
      */
     public /*out*/ readonly endpoint!: pulumi.Output<string>;
+    /**
+     * KPS Enabled on the project
+     */
+    public readonly kps_enabled!: pulumi.Output<boolean | undefined>;
     /**
      * Name of the project
      */
@@ -68,10 +86,18 @@ export class Project extends pulumi.CustomResource {
      * Organization ID of the project
      */
     public readonly organization_id!: pulumi.Output<string>;
+    /**
+     * Plan of the project
+     */
+    public readonly plan!: pulumi.Output<enums.Plan | undefined>;
     /**
      * Region of the project
      */
     public readonly region!: pulumi.Output<enums.Region>;
+    /**
+     * Provisioning status of the project (e.g. ACTIVE_HEALTHY)
+     */
+    public /*out*/ readonly status!: pulumi.Output<string | undefined>;
 
     /**
      * Create a Project resource with the given unique name, arguments, and options.
@@ -103,6 +129,7 @@ export class Project extends pulumi.CustomResource {
                 throw new Error("Missing required property 'region'");
             }
             resourceInputs["db_pass"] = args?.db_pass ? pulumi.secret(args.db_pass) : undefined;
+            resourceInputs["deletionProtection"] = (args ? args.deletionProtection : undefined) ?? true;
             resourceInputs["kps_enabled"] = args ? args.kps_enabled : undefined;
             resourceInputs["name"] = args ? args.name : undefined;
             resourceInputs["organization_id"] = args ? args.organization_id : undefined;
@@ -115,6 +142,7 @@ export class Project extends pulumi.CustomResource {
             resourceInputs["dbPort"] = undefined /*out*/;
             resourceInputs["dbUsername"] = undefined /*out*/;
             resourceInputs["endpoint"] = undefined /*out*/;
+            resourceInputs["status"] = undefined /*out*/;
         } else {
             resourceInputs["created_at"] = undefined /*out*/;
             resourceInputs["dbHost"] = undefined /*out*/;
@@ -122,10 +150,14 @@ export class Project extends pulumi.CustomResource {
             resourceInputs["dbPoolingPort"] = undefined /*out*/;
             resourceInputs["dbPort"] = undefined /*out*/;
             resourceInputs["dbUsername"] = undefined /*out*/;
+            resourceInputs["deletionProtection"] = undefined /*out*/;
             resourceInputs["endpoint"] = undefined /*out*/;
+            resourceInputs["kps_enabled"] = undefined /*out*/;
             resourceInputs["name"] = undefined /*out*/;
             resourceInputs["organization_id"] = undefined /*out*/;
+            resourceInputs["plan"] = undefined /*out*/;
             resourceInputs["region"] = undefined /*out*/;
+            resourceInputs["status"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
         super(Project.__pulumiType, name, resourceInputs, opts);
@@ -140,6 +172,10 @@ export interface ProjectArgs {
      * Postgres password of the project
      */
     db_pass: pulumi.Input<string>;
+    /**
+     * Refuse to delete the project while enabled, set to false to allow `pulumi destroy` or replacement
+     */
+    deletionProtection?: pulumi.Input<boolean>;
     /**
      * KPS Enabled on the project
      */
@@ -0,0 +1,148 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
+import * as utilities from "./utilities";
+
+/**
+ * Auth configuration of a project. Only the settings given are managed, the others are left as is,
+ * and deleting the resource leaves the configuration of the project untouched.
+ *
+ * The configuration of a project can be imported with its reference, every setting is then adopted:
+ * `pulumi import supabase:index:ProjectAuthConfig auth <projectRef>`
+ */
+export class ProjectAuthConfig extends pulumi.CustomResource {
+    /**
+     * Get an existing ProjectAuthConfig resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): ProjectAuthConfig {
+        return new ProjectAuthConfig(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'supabase:index:ProjectAuthConfig';
+
+    /**
+     * Returns true if the given object is an instance of ProjectAuthConfig.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is ProjectAuthConfig {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === ProjectAuthConfig.__pulumiType;
+    }
+
+    /**
+     * Other URLs users may be redirected to after signing in
+     */
+    public readonly additionalRedirectUrls!: pulumi.Output<string[] | undefined>;
+    /**
+     * Refuse the sign up of new users
+     */
+    public readonly disableSignup!: pulumi.Output<boolean | undefined>;
+    /**
+     * Email sign in settings
+     */
+    public readonly email!: pulumi.Output<outputs.ProjectAuthEmail | undefined>;
+    /**
+     * OAuth providers by name (apple, azure, github, google, ...)
+     */
+    public readonly externalProviders!: pulumi.Output<{[key: string]: outputs.ProjectAuthExternalProvider} | undefined>;
+    /**
+     * Lifetime of the access tokens in seconds (up to 604800)
+     */
+    public readonly jwtExpiry!: pulumi.Output<number | undefined>;
+    /**
+     * ID of the project
+     */
+    public readonly projectId!: pulumi.Output<string>;
+    /**
+     * URL users are redirected to after signing in when no redirect URL is given
+     */
+    public readonly siteUrl!: pulumi.Output<string | undefined>;
+    /**
+     * Phone sign in settings
+     */
+    public readonly sms!: pulumi.Output<outputs.ProjectAuthSms | undefined>;
+
+    /**
+     * Create a ProjectAuthConfig resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ProjectAuthConfigArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.projectId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'projectId'");
+            }
+            resourceInputs["additionalRedirectUrls"] = args ? args.additionalRedirectUrls : undefined;
+            resourceInputs["disableSignup"] = args ? args.disableSignup : undefined;
+            resourceInputs["email"] = args ? args.email : undefined;
+            resourceInputs["externalProviders"] = args ? args.externalProviders : undefined;
+            resourceInputs["jwtExpiry"] = args ? args.jwtExpiry : undefined;
+            resourceInputs["projectId"] = args ? args.projectId : undefined;
+            resourceInputs["siteUrl"] = args ? args.siteUrl : undefined;
+            resourceInputs["sms"] = args ? args.sms : undefined;
+        } else {
+            resourceInputs["additionalRedirectUrls"] = undefined /*out*/;
+            resourceInputs["disableSignup"] = undefined /*out*/;
+            resourceInputs["email"] = undefined /*out*/;
+            resourceInputs["externalProviders"] = undefined /*out*/;
+            resourceInputs["jwtExpiry"] = undefined /*out*/;
+            resourceInputs["projectId"] = undefined /*out*/;
+            resourceInputs["siteUrl"] = undefined /*out*/;
+            resourceInputs["sms"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(ProjectAuthConfig.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a ProjectAuthConfig resource.
+ */
+export interface ProjectAuthConfigArgs {
+    /**
+     * Other URLs users may be redirected to after signing in
+     */
+    additionalRedirectUrls?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Refuse the sign up of new users
+     */
+    disableSignup?: pulumi.Input<boolean>;
+    /**
+     * Email sign in settings
+     */
+    email?: pulumi.Input<inputs.ProjectAuthEmailArgs>;
+    /**
+     * OAuth providers by name (apple, azure, github, google, ...)
+     */
+    externalProviders?: pulumi.Input<{[key: string]: pulumi.Input<inputs.ProjectAuthExternalProviderArgs>}>;
+    /**
+     * Lifetime of the access tokens in seconds (up to 604800)
+     */
+    jwtExpiry?: pulumi.Input<number>;
+    /**
+     * ID of the project
+     */
+    projectId: pulumi.Input<string>;
+    /**
+     * URL users are redirected to after signing in when no redirect URL is given
+     */
+    siteUrl?: pulumi.Input<string>;
+    /**
+     * Phone sign in settings
+     */
+    sms?: pulumi.Input<inputs.ProjectAuthSmsArgs>;
+}
@@ -4,6 +4,12 @@
 import * as pulumi from "@pulumi/pulumi";
 import * as utilities from "./utilities";
 
+/**
+ * Edge function secret of a project.
+ *
+ * Existing secrets can be imported with their project reference and name:
+ * `pulumi import supabase:index:Secret my-secret <projectRef>/<name>`
+ */
 export class Secret extends pulumi.CustomResource {
     /**
      * Get an existing Secret resource's state with the given name, ID, and optional extra
@@ -31,10 +37,18 @@ export class Secret extends pulumi.CustomResource {
         return obj['__pulumiType'] === Secret.__pulumiType;
     }
 
+    /**
+     * SHA-256 digest of the deployed value, used to detect changes made outside of Pulumi
+     */
+    public /*out*/ readonly digest!: pulumi.Output<string>;
     /**
      * Name of the secret
      */
     public readonly name!: pulumi.Output<string>;
+    /**
+     * ID of the project
+     */
+    public readonly projectId!: pulumi.Output<string | undefined>;
     /**
      * Value of the secret
      */
@@ -63,12 +77,15 @@ export class Secret extends pulumi.CustomResource {
             resourceInputs["name"] = args ? args.name : undefined;
             resourceInputs["projectId"] = args ? args.projectId : undefined;
             resourceInputs["value"] = args?.value ? pulumi.secret(args.value) : undefined;
+            resourceInputs["digest"] = undefined /*out*/;
         } else {
+            resourceInputs["digest"] = undefined /*out*/;
             resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["projectId"] = undefined /*out*/;
             resourceInputs["value"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
-        const secretOpts = { additionalSecretOutputs: ["value"] };
+        const secretOpts = { additionalSecretOutputs: ["digest", "value"] };
         opts = pulumi.mergeOptions(opts, secretOpts);
         super(Secret.__pulumiType, name, resourceInputs, opts);
     }
@@ -0,0 +1,107 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * All the edge function secrets of a project managed together, every change is applied in a single API call.
+ *
+ * Existing secrets can be adopted with the project reference: `pulumi import supabase:index:SecretSet secrets <projectRef>`,
+ * their values are not readable so they are written again on the next update.
+ */
+export class SecretSet extends pulumi.CustomResource {
+    /**
+     * Get an existing SecretSet resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): SecretSet {
+        return new SecretSet(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'supabase:index:SecretSet';
+
+    /**
+     * Returns true if the given object is an instance of SecretSet.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is SecretSet {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === SecretSet.__pulumiType;
+    }
+
+    /**
+     * SHA-256 digest of every deployed value, used to detect changes made outside of Pulumi
+     */
+    public /*out*/ readonly digests!: pulumi.Output<{[key: string]: string}>;
+    /**
+     * ID of the project
+     */
+    public readonly projectId!: pulumi.Output<string>;
+    /**
+     * Delete the secrets of the project missing from `secrets`
+     */
+    public readonly prune!: pulumi.Output<boolean | undefined>;
+    /**
+     * Secret values by name
+     */
+    public readonly secrets!: pulumi.Output<{[key: string]: string}>;
+
+    /**
+     * Create a SecretSet resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: SecretSetArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.projectId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'projectId'");
+            }
+            if ((!args || args.secrets === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'secrets'");
+            }
+            resourceInputs["projectId"] = args ? args.projectId : undefined;
+            resourceInputs["prune"] = (args ? args.prune : undefined) ?? false;
+            resourceInputs["secrets"] = args?.secrets ? pulumi.secret(args.secrets) : undefined;
+            resourceInputs["digests"] = undefined /*out*/;
+        } else {
+            resourceInputs["digests"] = undefined /*out*/;
+            resourceInputs["projectId"] = undefined /*out*/;
+            resourceInputs["prune"] = undefined /*out*/;
+            resourceInputs["secrets"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["digests", "secrets"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        super(SecretSet.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a SecretSet resource.
+ */
+export interface SecretSetArgs {
+    /**
+     * ID of the project
+     */
+    projectId: pulumi.Input<string>;
+    /**
+     * Delete the secrets of the project missing from `secrets` (secrets prefixed with SUPABASE_ are kept)
+     */
+    prune?: pulumi.Input<boolean>;
+    /**
+     * Secret values by name
+     */
+    secrets: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+}
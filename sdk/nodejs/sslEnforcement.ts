@@ -0,0 +1,92 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * SSL enforcement of the database of a project. Deleting the resource stops enforcing SSL, as on a new project.
+ *
+ * The enforcement of a project can be imported with its reference:
+ * `pulumi import supabase:index:SslEnforcement ssl <projectRef>`
+ */
+export class SslEnforcement extends pulumi.CustomResource {
+    /**
+     * Get an existing SslEnforcement resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): SslEnforcement {
+        return new SslEnforcement(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'supabase:index:SslEnforcement';
+
+    /**
+     * Returns true if the given object is an instance of SslEnforcement.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is SslEnforcement {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === SslEnforcement.__pulumiType;
+    }
+
+    /**
+     * Whether the requested enforcement was applied to the database
+     */
+    public /*out*/ readonly appliedSuccessfully!: pulumi.Output<boolean>;
+    /**
+     * Whether connections to the database must use SSL
+     */
+    public readonly database!: pulumi.Output<boolean>;
+    /**
+     * ID of the project
+     */
+    public readonly projectId!: pulumi.Output<string>;
+
+    /**
+     * Create a SslEnforcement resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: SslEnforcementArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.projectId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'projectId'");
+            }
+            resourceInputs["database"] = (args ? args.database : undefined) ?? true;
+            resourceInputs["projectId"] = args ? args.projectId : undefined;
+            resourceInputs["appliedSuccessfully"] = undefined /*out*/;
+        } else {
+            resourceInputs["appliedSuccessfully"] = undefined /*out*/;
+            resourceInputs["database"] = undefined /*out*/;
+            resourceInputs["projectId"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(SslEnforcement.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a SslEnforcement resource.
+ */
+export interface SslEnforcementArgs {
+    /**
+     * Whether connections to the database must use SSL
+     */
+    database?: pulumi.Input<boolean>;
+    /**
+     * ID of the project
+     */
+    projectId: pulumi.Input<string>;
+}
@@ -0,0 +1,100 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
+
+export interface ProjectAuthEmailArgs {
+    /**
+     * Confirm the email of new users without sending a confirmation email
+     */
+    autoconfirm?: pulumi.Input<boolean>;
+    /**
+     * Allow users to sign up and sign in with their email
+     */
+    enabled?: pulumi.Input<boolean>;
+    /**
+     * Custom SMTP server the auth emails are sent with
+     */
+    smtp?: pulumi.Input<inputs.ProjectAuthSmtpArgs>;
+}
+
+export interface ProjectAuthExternalProviderArgs {
+    /**
+     * OAuth client ID
+     */
+    clientId?: pulumi.Input<string>;
+    /**
+     * Allow users to sign in with the provider
+     */
+    enabled?: pulumi.Input<boolean>;
+    /**
+     * OAuth client secret
+     */
+    secret?: pulumi.Input<string>;
+    /**
+     * URL of the provider, for the self-hosted ones (azure, gitlab, keycloak, workos)
+     */
+    url?: pulumi.Input<string>;
+}
+
+export interface ProjectAuthSmsArgs {
+    /**
+     * Confirm the phone number of new users without sending a confirmation SMS
+     */
+    autoconfirm?: pulumi.Input<boolean>;
+    /**
+     * Allow users to sign up and sign in with their phone number
+     */
+    enabled?: pulumi.Input<boolean>;
+    /**
+     * SMS provider (twilio, twilio_verify, messagebird, textlocal or vonage)
+     */
+    provider?: pulumi.Input<string>;
+    /**
+     * Twilio credentials, used by the twilio provider
+     */
+    twilio?: pulumi.Input<inputs.ProjectAuthTwilioArgs>;
+}
+
+export interface ProjectAuthSmtpArgs {
+    /**
+     * Email address the auth emails are sent from
+     */
+    adminEmail?: pulumi.Input<string>;
+    /**
+     * Hostname of the SMTP server
+     */
+    host?: pulumi.Input<string>;
+    /**
+     * Password of the SMTP server
+     */
+    pass?: pulumi.Input<string>;
+    /**
+     * Port of the SMTP server
+     */
+    port?: pulumi.Input<number>;
+    /**
+     * Name the auth emails are sent from
+     */
+    senderName?: pulumi.Input<string>;
+    /**
+     * Username of the SMTP server
+     */
+    user?: pulumi.Input<string>;
+}
+
+export interface ProjectAuthTwilioArgs {
+    /**
+     * Twilio account SID
+     */
+    accountSid?: pulumi.Input<string>;
+    /**
+     * Twilio auth token
+     */
+    authToken?: pulumi.Input<string>;
+    /**
+     * Twilio message service SID
+     */
+    messageServiceSid?: pulumi.Input<string>;
+}
@@ -0,0 +1,119 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
+
+/**
+ * DNS record to create at the DNS provider of a hostname
+ */
+export interface DnsRecord {
+    /**
+     * Name of the record
+     */
+    name: string;
+    /**
+     * Type of the record (CNAME or TXT)
+     */
+    type: string;
+    /**
+     * Value of the record
+     */
+    value: string;
+}
+
+export interface ProjectAuthEmail {
+    /**
+     * Confirm the email of new users without sending a confirmation email
+     */
+    autoconfirm?: boolean;
+    /**
+     * Allow users to sign up and sign in with their email
+     */
+    enabled?: boolean;
+    /**
+     * Custom SMTP server the auth emails are sent with
+     */
+    smtp?: outputs.ProjectAuthSmtp;
+}
+
+export interface ProjectAuthExternalProvider {
+    /**
+     * OAuth client ID
+     */
+    clientId?: string;
+    /**
+     * Allow users to sign in with the provider
+     */
+    enabled?: boolean;
+    /**
+     * OAuth client secret
+     */
+    secret?: string;
+    /**
+     * URL of the provider, for the self-hosted ones (azure, gitlab, keycloak, workos)
+     */
+    url?: string;
+}
+
+export interface ProjectAuthSms {
+    /**
+     * Confirm the phone number of new users without sending a confirmation SMS
+     */
+    autoconfirm?: boolean;
+    /**
+     * Allow users to sign up and sign in with their phone number
+     */
+    enabled?: boolean;
+    /**
+     * SMS provider (twilio, twilio_verify, messagebird, textlocal or vonage)
+     */
+    provider?: string;
+    /**
+     * Twilio credentials, used by the twilio provider
+     */
+    twilio?: outputs.ProjectAuthTwilio;
+}
+
+export interface ProjectAuthSmtp {
+    /**
+     * Email address the auth emails are sent from
+     */
+    adminEmail?: string;
+    /**
+     * Hostname of the SMTP server
+     */
+    host?: string;
+    /**
+     * Password of the SMTP server
+     */
+    pass?: string;
+    /**
+     * Port of the SMTP server
+     */
+    port?: number;
+    /**
+     * Name the auth emails are sent from
+     */
+    senderName?: string;
+    /**
+     * Username of the SMTP server
+     */
+    user?: string;
+}
+
+export interface ProjectAuthTwilio {
+    /**
+     * Twilio account SID
+     */
+    accountSid?: string;
+    /**
+     * Twilio auth token
+     */
+    authToken?: string;
+    /**
+     * Twilio message service SID
+     */
+    messageServiceSid?: string;
+}
+
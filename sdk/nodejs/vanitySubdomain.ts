@@ -0,0 +1,102 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Vanity subdomain of a project. Its availability is checked during the preview, and deleting the resource
+ * removes the subdomain.
+ *
+ * The vanity subdomain of a project can be imported with its reference:
+ * `pulumi import supabase:index:VanitySubdomain subdomain <projectRef>`
+ */
+export class VanitySubdomain extends pulumi.CustomResource {
+    /**
+     * Get an existing VanitySubdomain resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): VanitySubdomain {
+        return new VanitySubdomain(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'supabase:index:VanitySubdomain';
+
+    /**
+     * Returns true if the given object is an instance of VanitySubdomain.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is VanitySubdomain {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === VanitySubdomain.__pulumiType;
+    }
+
+    /**
+     * Domain serving the project, <subdomain>.supabase.co
+     */
+    public /*out*/ readonly customDomain!: pulumi.Output<string>;
+    /**
+     * ID of the project
+     */
+    public readonly projectId!: pulumi.Output<string>;
+    /**
+     * Status of the subdomain (active, custom-domain-used or not-used)
+     */
+    public /*out*/ readonly status!: pulumi.Output<string>;
+    /**
+     * Vanity subdomain of the project, served as <subdomain>.supabase.co
+     */
+    public readonly subdomain!: pulumi.Output<string>;
+
+    /**
+     * Create a VanitySubdomain resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: VanitySubdomainArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.projectId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'projectId'");
+            }
+            if ((!args || args.subdomain === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'subdomain'");
+            }
+            resourceInputs["projectId"] = args ? args.projectId : undefined;
+            resourceInputs["subdomain"] = args ? args.subdomain : undefined;
+            resourceInputs["customDomain"] = undefined /*out*/;
+            resourceInputs["status"] = undefined /*out*/;
+        } else {
+            resourceInputs["customDomain"] = undefined /*out*/;
+            resourceInputs["projectId"] = undefined /*out*/;
+            resourceInputs["status"] = undefined /*out*/;
+            resourceInputs["subdomain"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(VanitySubdomain.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a VanitySubdomain resource.
+ */
+export interface VanitySubdomainArgs {
+    /**
+     * ID of the project
+     */
+    projectId: pulumi.Input<string>;
+    /**
+     * Vanity subdomain of the project, served as <subdomain>.supabase.co
+     */
+    subdomain: pulumi.Input<string>;
+}
@@ -6,12 +6,23 @@ from . import _utilities
 import typing
 # Export this package's modules as members:
 from ._enums import *
+from .custom_hostname import *
 from .function import *
+from .get_network_bans import *
 from .get_type_script import *
+from .network_ban_removal import *
+from .network_restrictions import *
 from .organization import *
+from .postgrest_config import *
 from .project import *
+from .project_auth_config import *
 from .provider import *
 from .secret import *
+from .secret_set import *
+from .ssl_enforcement import *
+from .vanity_subdomain import *
+from ._inputs import *
+from . import outputs
 
 # Make subpackages available:
 if typing.TYPE_CHECKING:
@@ -28,10 +39,18 @@ _utilities.register(
   "mod": "index",
   "fqn": "pulumi_supabase",
   "classes": {
+   "supabase:index:CustomHostname": "CustomHostname",
    "supabase:index:Function": "Function",
+   "supabase:index:NetworkBanRemoval": "NetworkBanRemoval",
+   "supabase:index:NetworkRestrictions": "NetworkRestrictions",
    "supabase:index:Organization": "Organization",
+   "supabase:index:PostgrestConfig": "PostgrestConfig",
    "supabase:index:Project": "Project",
-   "supabase:index:Secret": "Secret"
+   "supabase:index:ProjectAuthConfig": "ProjectAuthConfig",
+   "supabase:index:Secret": "Secret",
+   "supabase:index:SecretSet": "SecretSet",
+   "supabase:index:SslEnforcement": "SslEnforcement",
+   "supabase:index:VanitySubdomain": "VanitySubdomain"
   }
  }
 ]
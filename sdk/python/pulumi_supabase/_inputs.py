@@ -0,0 +1,374 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from ._enums import *
+
+__all__ = [
+    'ProjectAuthEmailArgs',
+    'ProjectAuthExternalProviderArgs',
+    'ProjectAuthSmsArgs',
+    'ProjectAuthSmtpArgs',
+    'ProjectAuthTwilioArgs',
+]
+
+@pulumi.input_type
+class ProjectAuthEmailArgs:
+    def __init__(__self__, *,
+                 autoconfirm: Optional[pulumi.Input[bool]] = None,
+                 enabled: Optional[pulumi.Input[bool]] = None,
+                 smtp: Optional[pulumi.Input['ProjectAuthSmtpArgs']] = None):
+        """
+        :param pulumi.Input[bool] autoconfirm: Confirm the email of new users without sending a confirmation email
+        :param pulumi.Input[bool] enabled: Allow users to sign up and sign in with their email
+        :param pulumi.Input['ProjectAuthSmtpArgs'] smtp: Custom SMTP server the auth emails are sent with
+        """
+        if autoconfirm is not None:
+            pulumi.set(__self__, "autoconfirm", autoconfirm)
+        if enabled is not None:
+            pulumi.set(__self__, "enabled", enabled)
+        if smtp is not None:
+            pulumi.set(__self__, "smtp", smtp)
+
+    @property
+    @pulumi.getter
+    def autoconfirm(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Confirm the email of new users without sending a confirmation email
+        """
+        return pulumi.get(self, "autoconfirm")
+
+    @autoconfirm.setter
+    def autoconfirm(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "autoconfirm", value)
+
+    @property
+    @pulumi.getter
+    def enabled(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Allow users to sign up and sign in with their email
+        """
+        return pulumi.get(self, "enabled")
+
+    @enabled.setter
+    def enabled(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "enabled", value)
+
+    @property
+    @pulumi.getter
+    def smtp(self) -> Optional[pulumi.Input['ProjectAuthSmtpArgs']]:
+        """
+        Custom SMTP server the auth emails are sent with
+        """
+        return pulumi.get(self, "smtp")
+
+    @smtp.setter
+    def smtp(self, value: Optional[pulumi.Input['ProjectAuthSmtpArgs']]):
+        pulumi.set(self, "smtp", value)
+
+
+@pulumi.input_type
+class ProjectAuthExternalProviderArgs:
+    def __init__(__self__, *,
+                 client_id: Optional[pulumi.Input[str]] = None,
+                 enabled: Optional[pulumi.Input[bool]] = None,
+                 secret: Optional[pulumi.Input[str]] = None,
+                 url: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input[str] client_id: OAuth client ID
+        :param pulumi.Input[bool] enabled: Allow users to sign in with the provider
+        :param pulumi.Input[str] secret: OAuth client secret
+        :param pulumi.Input[str] url: URL of the provider, for the self-hosted ones (azure, gitlab, keycloak, workos)
+        """
+        if client_id is not None:
+            pulumi.set(__self__, "client_id", client_id)
+        if enabled is not None:
+            pulumi.set(__self__, "enabled", enabled)
+        if secret is not None:
+            pulumi.set(__self__, "secret", secret)
+        if url is not None:
+            pulumi.set(__self__, "url", url)
+
+    @property
+    @pulumi.getter(name="clientId")
+    def client_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        OAuth client ID
+        """
+        return pulumi.get(self, "client_id")
+
+    @client_id.setter
+    def client_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "client_id", value)
+
+    @property
+    @pulumi.getter
+    def enabled(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Allow users to sign in with the provider
+        """
+        return pulumi.get(self, "enabled")
+
+    @enabled.setter
+    def enabled(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "enabled", value)
+
+    @property
+    @pulumi.getter
+    def secret(self) -> Optional[pulumi.Input[str]]:
+        """
+        OAuth client secret
+        """
+        return pulumi.get(self, "secret")
+
+    @secret.setter
+    def secret(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "secret", value)
+
+    @property
+    @pulumi.getter
+    def url(self) -> Optional[pulumi.Input[str]]:
+        """
+        URL of the provider, for the self-hosted ones (azure, gitlab, keycloak, workos)
+        """
+        return pulumi.get(self, "url")
+
+    @url.setter
+    def url(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "url", value)
+
+
+@pulumi.input_type
+class ProjectAuthSmsArgs:
+    def __init__(__self__, *,
+                 autoconfirm: Optional[pulumi.Input[bool]] = None,
+                 enabled: Optional[pulumi.Input[bool]] = None,
+                 provider: Optional[pulumi.Input[str]] = None,
+                 twilio: Optional[pulumi.Input['ProjectAuthTwilioArgs']] = None):
+        """
+        :param pulumi.Input[bool] autoconfirm: Confirm the phone number of new users without sending a confirmation SMS
+        :param pulumi.Input[bool] enabled: Allow users to sign up and sign in with their phone number
+        :param pulumi.Input[str] provider: SMS provider (twilio, twilio_verify, messagebird, textlocal or vonage)
+        :param pulumi.Input['ProjectAuthTwilioArgs'] twilio: Twilio credentials, used by the twilio provider
+        """
+        if autoconfirm is not None:
+            pulumi.set(__self__, "autoconfirm", autoconfirm)
+        if enabled is not None:
+            pulumi.set(__self__, "enabled", enabled)
+        if provider is not None:
+            pulumi.set(__self__, "provider", provider)
+        if twilio is not None:
+            pulumi.set(__self__, "twilio", twilio)
+
+    @property
+    @pulumi.getter
+    def autoconfirm(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Confirm the phone number of new users without sending a confirmation SMS
+        """
+        return pulumi.get(self, "autoconfirm")
+
+    @autoconfirm.setter
+    def autoconfirm(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "autoconfirm", value)
+
+    @property
+    @pulumi.getter
+    def enabled(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Allow users to sign up and sign in with their phone number
+        """
+        return pulumi.get(self, "enabled")
+
+    @enabled.setter
+    def enabled(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "enabled", value)
+
+    @property
+    @pulumi.getter
+    def provider(self) -> Optional[pulumi.Input[str]]:
+        """
+        SMS provider (twilio, twilio_verify, messagebird, textlocal or vonage)
+        """
+        return pulumi.get(self, "provider")
+
+    @provider.setter
+    def provider(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "provider", value)
+
+    @property
+    @pulumi.getter
+    def twilio(self) -> Optional[pulumi.Input['ProjectAuthTwilioArgs']]:
+        """
+        Twilio credentials, used by the twilio provider
+        """
+        return pulumi.get(self, "twilio")
+
+    @twilio.setter
+    def twilio(self, value: Optional[pulumi.Input['ProjectAuthTwilioArgs']]):
+        pulumi.set(self, "twilio", value)
+
+
+@pulumi.input_type
+class ProjectAuthSmtpArgs:
+    def __init__(__self__, *,
+                 admin_email: Optional[pulumi.Input[str]] = None,
+                 host: Optional[pulumi.Input[str]] = None,
+                 pass_: Optional[pulumi.Input[str]] = None,
+                 port: Optional[pulumi.Input[int]] = None,
+                 sender_name: Optional[pulumi.Input[str]] = None,
+                 user: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input[str] admin_email: Email address the auth emails are sent from
+        :param pulumi.Input[str] host: Hostname of the SMTP server
+        :param pulumi.Input[str] pass_: Password of the SMTP server
+        :param pulumi.Input[int] port: Port of the SMTP server
+        :param pulumi.Input[str] sender_name: Name the auth emails are sent from
+        :param pulumi.Input[str] user: Username of the SMTP server
+        """
+        if admin_email is not None:
+            pulumi.set(__self__, "admin_email", admin_email)
+        if host is not None:
+            pulumi.set(__self__, "host", host)
+        if pass_ is not None:
+            pulumi.set(__self__, "pass_", pass_)
+        if port is not None:
+            pulumi.set(__self__, "port", port)
+        if sender_name is not None:
+            pulumi.set(__self__, "sender_name", sender_name)
+        if user is not None:
+            pulumi.set(__self__, "user", user)
+
+    @property
+    @pulumi.getter(name="adminEmail")
+    def admin_email(self) -> Optional[pulumi.Input[str]]:
+        """
+        Email address the auth emails are sent from
+        """
+        return pulumi.get(self, "admin_email")
+
+    @admin_email.setter
+    def admin_email(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "admin_email", value)
+
+    @property
+    @pulumi.getter
+    def host(self) -> Optional[pulumi.Input[str]]:
+        """
+        Hostname of the SMTP server
+        """
+        return pulumi.get(self, "host")
+
+    @host.setter
+    def host(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "host", value)
+
+    @property
+    @pulumi.getter(name="pass")
+    def pass_(self) -> Optional[pulumi.Input[str]]:
+        """
+        Password of the SMTP server
+        """
+        return pulumi.get(self, "pass_")
+
+    @pass_.setter
+    def pass_(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "pass_", value)
+
+    @property
+    @pulumi.getter
+    def port(self) -> Optional[pulumi.Input[int]]:
+        """
+        Port of the SMTP server
+        """
+        return pulumi.get(self, "port")
+
+    @port.setter
+    def port(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "port", value)
+
+    @property
+    @pulumi.getter(name="senderName")
+    def sender_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        Name the auth emails are sent from
+        """
+        return pulumi.get(self, "sender_name")
+
+    @sender_name.setter
+    def sender_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "sender_name", value)
+
+    @property
+    @pulumi.getter
+    def user(self) -> Optional[pulumi.Input[str]]:
+        """
+        Username of the SMTP server
+        """
+        return pulumi.get(self, "user")
+
+    @user.setter
+    def user(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "user", value)
+
+
+@pulumi.input_type
+class ProjectAuthTwilioArgs:
+    def __init__(__self__, *,
+                 account_sid: Optional[pulumi.Input[str]] = None,
+                 auth_token: Optional[pulumi.Input[str]] = None,
+                 message_service_sid: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input[str] account_sid: Twilio account SID
+        :param pulumi.Input[str] auth_token: Twilio auth token
+        :param pulumi.Input[str] message_service_sid: Twilio message service SID
+        """
+        if account_sid is not None:
+            pulumi.set(__self__, "account_sid", account_sid)
+        if auth_token is not None:
+            pulumi.set(__self__, "auth_token", auth_token)
+        if message_service_sid is not None:
+            pulumi.set(__self__, "message_service_sid", message_service_sid)
+
+    @property
+    @pulumi.getter(name="accountSid")
+    def account_sid(self) -> Optional[pulumi.Input[str]]:
+        """
+        Twilio account SID
+        """
+        return pulumi.get(self, "account_sid")
+
+    @account_sid.setter
+    def account_sid(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "account_sid", value)
+
+    @property
+    @pulumi.getter(name="authToken")
+    def auth_token(self) -> Optional[pulumi.Input[str]]:
+        """
+        Twilio auth token
+        """
+        return pulumi.get(self, "auth_token")
+
+    @auth_token.setter
+    def auth_token(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "auth_token", value)
+
+    @property
+    @pulumi.getter(name="messageServiceSid")
+    def message_service_sid(self) -> Optional[pulumi.Input[str]]:
+        """
+        Twilio message service SID
+        """
+        return pulumi.get(self, "message_service_sid")
+
+    @message_service_sid.setter
+    def message_service_sid(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "message_service_sid", value)
+
+
@@ -6,7 +6,27 @@ import warnings
 import pulumi
 import pulumi.runtime
 from typing import Any, Mapping, Optional, Sequence, Union, overload
-from .. import _utilities
+from . import _utilities
+
+maxRequestsPerSecond: float
+"""
+Client side limit of Management API requests per second shared by all resource operations, 0 disables it
+"""
+
+maxRetries: int
+"""
+Maximum number of retries for rate limited (429) or transient Management API failures
+"""
+
+retryBaseDelay: float
+"""
+Initial delay in seconds between retries, doubled on every attempt with jitter
+"""
+
+retryMaxDelay: float
+"""
+Maximum delay in seconds between retries, a longer Retry-After from the API is capped to it
+"""
 
 server: str
 """
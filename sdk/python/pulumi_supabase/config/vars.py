@@ -6,7 +6,7 @@ import warnings
 import pulumi
 import pulumi.runtime
 from typing import Any, Mapping, Optional, Sequence, Union, overload
-from .. import _utilities
+from . import _utilities
 
 import types
 
@@ -14,6 +14,34 @@ __config__ = pulumi.Config('supabase')
 
 
 class _ExportableConfig(types.ModuleType):
+    @property
+    def max_requests_per_second(self) -> float:
+        """
+        Client side limit of Management API requests per second shared by all resource operations, 0 disables it
+        """
+        return __config__.get_float('maxRequestsPerSecond') or 1
+
+    @property
+    def max_retries(self) -> int:
+        """
+        Maximum number of retries for rate limited (429) or transient Management API failures
+        """
+        return __config__.get_int('maxRetries') or 3
+
+    @property
+    def retry_base_delay(self) -> float:
+        """
+        Initial delay in seconds between retries, doubled on every attempt with jitter
+        """
+        return __config__.get_float('retryBaseDelay') or 1
+
+    @property
+    def retry_max_delay(self) -> float:
+        """
+        Maximum delay in seconds between retries, a longer Retry-After from the API is capped to it
+        """
+        return __config__.get_float('retryMaxDelay') or 30
+
     @property
     def server(self) -> str:
         """
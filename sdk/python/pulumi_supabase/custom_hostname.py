@@ -0,0 +1,221 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from . import outputs
+
+__all__ = ['CustomHostnameArgs', 'CustomHostname']
+
+@pulumi.input_type
+class CustomHostnameArgs:
+    def __init__(__self__, *,
+                 hostname: pulumi.Input[str],
+                 project_id: pulumi.Input[str],
+                 wait_for_verification: Optional[pulumi.Input[bool]] = None):
+        """
+        The set of arguments for constructing a CustomHostname resource.
+        :param pulumi.Input[str] hostname: Custom hostname of the project, such as api.example.com
+        :param pulumi.Input[str] project_id: ID of the project
+        :param pulumi.Input[bool] wait_for_verification: Whether to wait for the DNS records to be verified and then activate the hostname
+        """
+        pulumi.set(__self__, "hostname", hostname)
+        pulumi.set(__self__, "project_id", project_id)
+        if wait_for_verification is None:
+            wait_for_verification = False
+        if wait_for_verification is not None:
+            pulumi.set(__self__, "wait_for_verification", wait_for_verification)
+
+    @property
+    @pulumi.getter
+    def hostname(self) -> pulumi.Input[str]:
+        """
+        Custom hostname of the project, such as api.example.com
+        """
+        return pulumi.get(self, "hostname")
+
+    @hostname.setter
+    def hostname(self, value: pulumi.Input[str]):
+        pulumi.set(self, "hostname", value)
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Input[str]:
+        """
+        ID of the project
+        """
+        return pulumi.get(self, "project_id")
+
+    @project_id.setter
+    def project_id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "project_id", value)
+
+    @property
+    @pulumi.getter(name="waitForVerification")
+    def wait_for_verification(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether to wait for the DNS records to be verified and then activate the hostname
+        """
+        return pulumi.get(self, "wait_for_verification")
+
+    @wait_for_verification.setter
+    def wait_for_verification(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "wait_for_verification", value)
+
+
+class CustomHostname(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 hostname: Optional[pulumi.Input[str]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 wait_for_verification: Optional[pulumi.Input[bool]] = None,
+                 __props__=None):
+        """
+        Custom hostname of a project. The `dnsRecords` output lists the CNAME and TXT records to create at the DNS provider
+        of the hostname. Once they exist, set `waitForVerification` to wait for the hostname to be verified and activate it.
+        Do not set it on creation when the DNS records depend on this resource, the verification would never succeed.
+        Deleting the resource removes the custom hostname.
+
+        The custom hostname of a project can be imported with its reference:
+        `pulumi import supabase:index:CustomHostname api <projectRef>`
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] hostname: Custom hostname of the project, such as api.example.com
+        :param pulumi.Input[str] project_id: ID of the project
+        :param pulumi.Input[bool] wait_for_verification: Whether to wait for the DNS records to be verified and then activate the hostname
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: CustomHostnameArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Custom hostname of a project. The `dnsRecords` output lists the CNAME and TXT records to create at the DNS provider
+        of the hostname. Once they exist, set `waitForVerification` to wait for the hostname to be verified and activate it.
+        Do not set it on creation when the DNS records depend on this resource, the verification would never succeed.
+        Deleting the resource removes the custom hostname.
+
+        The custom hostname of a project can be imported with its reference:
+        `pulumi import supabase:index:CustomHostname api <projectRef>`
+
+        :param str resource_name: The name of the resource.
+        :param CustomHostnameArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(CustomHostnameArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 hostname: Optional[pulumi.Input[str]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 wait_for_verification: Optional[pulumi.Input[bool]] = None,
+                 __props__=None):
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.plugin_download_url is None:
+            opts.plugin_download_url = _utilities.get_plugin_download_url()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = CustomHostnameArgs.__new__(CustomHostnameArgs)
+
+            if hostname is None and not opts.urn:
+                raise TypeError("Missing required property 'hostname'")
+            __props__.__dict__["hostname"] = hostname
+            if project_id is None and not opts.urn:
+                raise TypeError("Missing required property 'project_id'")
+            __props__.__dict__["project_id"] = project_id
+            if wait_for_verification is None:
+                wait_for_verification = False
+            __props__.__dict__["wait_for_verification"] = wait_for_verification
+            __props__.__dict__["activated"] = None
+            __props__.__dict__["dns_records"] = None
+            __props__.__dict__["status"] = None
+        super(CustomHostname, __self__).__init__(
+            'supabase:index:CustomHostname',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'CustomHostname':
+        """
+        Get an existing CustomHostname resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = CustomHostnameArgs.__new__(CustomHostnameArgs)
+
+        __props__.__dict__["activated"] = None
+        __props__.__dict__["dns_records"] = None
+        __props__.__dict__["hostname"] = None
+        __props__.__dict__["project_id"] = None
+        __props__.__dict__["status"] = None
+        return CustomHostname(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def activated(self) -> pulumi.Output[bool]:
+        """
+        Whether the hostname is verified and serves the project
+        """
+        return pulumi.get(self, "activated")
+
+    @property
+    @pulumi.getter(name="dnsRecords")
+    def dns_records(self) -> pulumi.Output[Sequence['outputs.DnsRecord']]:
+        """
+        DNS records to create for the hostname to be verified
+        """
+        return pulumi.get(self, "dns_records")
+
+    @property
+    @pulumi.getter
+    def hostname(self) -> pulumi.Output[str]:
+        """
+        Custom hostname of the project, such as api.example.com
+        """
+        return pulumi.get(self, "hostname")
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Output[str]:
+        """
+        ID of the project
+        """
+        return pulumi.get(self, "project_id")
+
+    @property
+    @pulumi.getter
+    def status(self) -> pulumi.Output[str]:
+        """
+        Verification status of the hostname (1_not_started to 5_services_reconfigured)
+        """
+        return pulumi.get(self, "status")
+
@@ -14,40 +14,45 @@ __all__ = ['FunctionArgs', 'Function']
 @pulumi.input_type
 class FunctionArgs:
     def __init__(__self__, *,
-                 body: pulumi.Input[str],
                  name: pulumi.Input[str],
                  project_id: pulumi.Input[str],
                  slug: pulumi.Input[str],
+                 body: Optional[pulumi.Input[str]] = None,
+                 entrypoint_path: Optional[pulumi.Input[str]] = None,
+                 import_map: Optional[pulumi.Input[str]] = None,
+                 import_map_path: Optional[pulumi.Input[str]] = None,
+                 source: Optional[pulumi.Input[str]] = None,
                  verify_jwt: Optional[pulumi.Input[bool]] = None):
         """
         The set of arguments for constructing a Function resource.
-        :param pulumi.Input[str] body: Body of the functino
         :param pulumi.Input[str] name: Name of the function
         :param pulumi.Input[str] project_id: ID of the project
         :param pulumi.Input[str] slug: Slug of the function
+        :param pulumi.Input[str] body: Body of the functino
+        :param pulumi.Input[str] entrypoint_path: Entrypoint of the function relative to its source directory (index.ts by default), or the file name body is deployed as
+        :param pulumi.Input[str] import_map: Import map of the function, given inline as JSON or as the path to its file. It replaces the import_map.json or deno.json found next to the entrypoint
+        :param pulumi.Input[str] import_map_path: Path the import map is deployed to, relative to the entrypoint directory. Its relative mappings are rewritten to point to the same modules. By default an import map file keeps its place relative to the modules
+        :param pulumi.Input[str] source: Local directory (with an index.ts entrypoint) or entrypoint file of the function, its local imports and import map are bundled and deployed in place of body
         :param pulumi.Input[bool] verify_jwt: Verify JWT before running
         """
-        pulumi.set(__self__, "body", body)
         pulumi.set(__self__, "name", name)
         pulumi.set(__self__, "project_id", project_id)
         pulumi.set(__self__, "slug", slug)
+        if body is not None:
+            pulumi.set(__self__, "body", body)
+        if entrypoint_path is not None:
+            pulumi.set(__self__, "entrypoint_path", entrypoint_path)
+        if import_map is not None:
+            pulumi.set(__self__, "import_map", import_map)
+        if import_map_path is not None:
+            pulumi.set(__self__, "import_map_path", import_map_path)
+        if source is not None:
+            pulumi.set(__self__, "source", source)
         if verify_jwt is None:
             verify_jwt = False
         if verify_jwt is not None:
             pulumi.set(__self__, "verify_jwt", verify_jwt)
 
-    @property
-    @pulumi.getter
-    def body(self) -> pulumi.Input[str]:
-        """
-        Body of the functino
-        """
-        return pulumi.get(self, "body")
-
-    @body.setter
-    def body(self, value: pulumi.Input[str]):
-        pulumi.set(self, "body", value)
-
     @property
     @pulumi.getter
     def name(self) -> pulumi.Input[str]:
@@ -84,6 +89,66 @@ class FunctionArgs:
     def slug(self, value: pulumi.Input[str]):
         pulumi.set(self, "slug", value)
 
+    @property
+    @pulumi.getter
+    def body(self) -> Optional[pulumi.Input[str]]:
+        """
+        Body of the functino
+        """
+        return pulumi.get(self, "body")
+
+    @body.setter
+    def body(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "body", value)
+
+    @property
+    @pulumi.getter(name="entrypointPath")
+    def entrypoint_path(self) -> Optional[pulumi.Input[str]]:
+        """
+        Entrypoint of the function relative to its source directory (index.ts by default), or the file name body is deployed as
+        """
+        return pulumi.get(self, "entrypoint_path")
+
+    @entrypoint_path.setter
+    def entrypoint_path(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "entrypoint_path", value)
+
+    @property
+    @pulumi.getter(name="importMap")
+    def import_map(self) -> Optional[pulumi.Input[str]]:
+        """
+        Import map of the function, given inline as JSON or as the path to its file. It replaces the import_map.json or deno.json found next to the entrypoint
+        """
+        return pulumi.get(self, "import_map")
+
+    @import_map.setter
+    def import_map(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "import_map", value)
+
+    @property
+    @pulumi.getter(name="importMapPath")
+    def import_map_path(self) -> Optional[pulumi.Input[str]]:
+        """
+        Path the import map is deployed to, relative to the entrypoint directory. Its relative mappings are rewritten to point to the same modules. By default an import map file keeps its place relative to the modules
+        """
+        return pulumi.get(self, "import_map_path")
+
+    @import_map_path.setter
+    def import_map_path(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "import_map_path", value)
+
+    @property
+    @pulumi.getter
+    def source(self) -> Optional[pulumi.Input[str]]:
+        """
+        Local directory (with an index.ts entrypoint) or entrypoint file of the function, its local imports and import map are bundled and deployed in place of body
+        """
+        return pulumi.get(self, "source")
+
+    @source.setter
+    def source(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "source", value)
+
     @property
     @pulumi.getter
     def verify_jwt(self) -> Optional[pulumi.Input[bool]]:
@@ -103,19 +168,31 @@ class Function(pulumi.CustomResource):
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
                  body: Optional[pulumi.Input[str]] = None,
+                 entrypoint_path: Optional[pulumi.Input[str]] = None,
+                 import_map: Optional[pulumi.Input[str]] = None,
+                 import_map_path: Optional[pulumi.Input[str]] = None,
                  name: Optional[pulumi.Input[str]] = None,
                  project_id: Optional[pulumi.Input[str]] = None,
                  slug: Optional[pulumi.Input[str]] = None,
+                 source: Optional[pulumi.Input[str]] = None,
                  verify_jwt: Optional[pulumi.Input[bool]] = None,
                  __props__=None):
         """
-        Create a Function resource with the given unique name, props, and options.
+        Edge function of a project.
+
+        Existing functions can be imported with their project reference and slug:
+        `pulumi import supabase:index:Function my-function <projectRef>/<slug>`
+
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
         :param pulumi.Input[str] body: Body of the functino
+        :param pulumi.Input[str] entrypoint_path: Entrypoint of the function relative to its source directory (index.ts by default), or the file name body is deployed as
+        :param pulumi.Input[str] import_map: Import map of the function, given inline as JSON or as the path to its file. It replaces the import_map.json or deno.json found next to the entrypoint
+        :param pulumi.Input[str] import_map_path: Path the import map is deployed to, relative to the entrypoint directory. Its relative mappings are rewritten to point to the same modules. By default an import map file keeps its place relative to the modules
         :param pulumi.Input[str] name: Name of the function
         :param pulumi.Input[str] project_id: ID of the project
         :param pulumi.Input[str] slug: Slug of the function
+        :param pulumi.Input[str] source: Local directory (with an index.ts entrypoint) or entrypoint file of the function, its local imports and import map are bundled and deployed in place of body
         :param pulumi.Input[bool] verify_jwt: Verify JWT before running
         """
         ...
@@ -125,7 +202,11 @@ class Function(pulumi.CustomResource):
                  args: FunctionArgs,
                  opts: Optional[pulumi.ResourceOptions] = None):
         """
-        Create a Function resource with the given unique name, props, and options.
+        Edge function of a project.
+
+        Existing functions can be imported with their project reference and slug:
+        `pulumi import supabase:index:Function my-function <projectRef>/<slug>`
+
         :param str resource_name: The name of the resource.
         :param FunctionArgs args: The arguments to use to populate this resource's properties.
         :param pulumi.ResourceOptions opts: Options for the resource.
@@ -142,9 +223,13 @@ class Function(pulumi.CustomResource):
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
                  body: Optional[pulumi.Input[str]] = None,
+                 entrypoint_path: Optional[pulumi.Input[str]] = None,
+                 import_map: Optional[pulumi.Input[str]] = None,
+                 import_map_path: Optional[pulumi.Input[str]] = None,
                  name: Optional[pulumi.Input[str]] = None,
                  project_id: Optional[pulumi.Input[str]] = None,
                  slug: Optional[pulumi.Input[str]] = None,
+                 source: Optional[pulumi.Input[str]] = None,
                  verify_jwt: Optional[pulumi.Input[bool]] = None,
                  __props__=None):
         if opts is None:
@@ -160,9 +245,10 @@ class Function(pulumi.CustomResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = FunctionArgs.__new__(FunctionArgs)
 
-            if body is None and not opts.urn:
-                raise TypeError("Missing required property 'body'")
             __props__.__dict__["body"] = None if body is None else pulumi.Output.secret(body)
+            __props__.__dict__["entrypoint_path"] = entrypoint_path
+            __props__.__dict__["import_map"] = import_map
+            __props__.__dict__["import_map_path"] = import_map_path
             if name is None and not opts.urn:
                 raise TypeError("Missing required property 'name'")
             __props__.__dict__["name"] = name
@@ -172,10 +258,14 @@ class Function(pulumi.CustomResource):
             if slug is None and not opts.urn:
                 raise TypeError("Missing required property 'slug'")
             __props__.__dict__["slug"] = slug
+            __props__.__dict__["source"] = source
             if verify_jwt is None:
                 verify_jwt = False
             __props__.__dict__["verify_jwt"] = verify_jwt
+            __props__.__dict__["body_hash"] = None
             __props__.__dict__["created_at"] = None
+            __props__.__dict__["has_import_map"] = None
+            __props__.__dict__["source_hash"] = None
             __props__.__dict__["status"] = None
             __props__.__dict__["updated_at"] = None
             __props__.__dict__["version"] = None
@@ -201,15 +291,30 @@ class Function(pulumi.CustomResource):
 
         __props__ = FunctionArgs.__new__(FunctionArgs)
 
+        __props__.__dict__["body_hash"] = None
         __props__.__dict__["created_at"] = None
+        __props__.__dict__["entrypoint_path"] = None
+        __props__.__dict__["has_import_map"] = None
+        __props__.__dict__["import_map"] = None
+        __props__.__dict__["import_map_path"] = None
         __props__.__dict__["name"] = None
+        __props__.__dict__["project_id"] = None
         __props__.__dict__["slug"] = None
+        __props__.__dict__["source_hash"] = None
         __props__.__dict__["status"] = None
         __props__.__dict__["updated_at"] = None
         __props__.__dict__["verify_jwt"] = None
         __props__.__dict__["version"] = None
         return Function(resource_name, opts=opts, __props__=__props__)
 
+    @property
+    @pulumi.getter(name="bodyHash")
+    def body_hash(self) -> pulumi.Output[Optional[str]]:
+        """
+        SHA-256 of the deployed body, stored in place of the body itself
+        """
+        return pulumi.get(self, "body_hash")
+
     @property
     @pulumi.getter
     def created_at(self) -> pulumi.Output[str]:
@@ -218,6 +323,38 @@ class Function(pulumi.CustomResource):
         """
         return pulumi.get(self, "created_at")
 
+    @property
+    @pulumi.getter(name="entrypointPath")
+    def entrypoint_path(self) -> pulumi.Output[Optional[str]]:
+        """
+        Entrypoint of the function
+        """
+        return pulumi.get(self, "entrypoint_path")
+
+    @property
+    @pulumi.getter(name="hasImportMap")
+    def has_import_map(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether the function is deployed with an import map
+        """
+        return pulumi.get(self, "has_import_map")
+
+    @property
+    @pulumi.getter(name="importMap")
+    def import_map(self) -> pulumi.Output[Optional[str]]:
+        """
+        Import map of the function, inline or as the path to its file
+        """
+        return pulumi.get(self, "import_map")
+
+    @property
+    @pulumi.getter(name="importMapPath")
+    def import_map_path(self) -> pulumi.Output[Optional[str]]:
+        """
+        Path the import map is deployed to
+        """
+        return pulumi.get(self, "import_map_path")
+
     @property
     @pulumi.getter
     def name(self) -> pulumi.Output[str]:
@@ -226,6 +363,14 @@ class Function(pulumi.CustomResource):
         """
         return pulumi.get(self, "name")
 
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Output[Optional[str]]:
+        """
+        ID of the project
+        """
+        return pulumi.get(self, "project_id")
+
     @property
     @pulumi.getter
     def slug(self) -> pulumi.Output[str]:
@@ -234,6 +379,14 @@ class Function(pulumi.CustomResource):
         """
         return pulumi.get(self, "slug")
 
+    @property
+    @pulumi.getter(name="sourceHash")
+    def source_hash(self) -> pulumi.Output[Optional[str]]:
+        """
+        SHA-256 of the deployed source bundle
+        """
+        return pulumi.get(self, "source_hash")
+
     @property
     @pulumi.getter
     def status(self) -> pulumi.Output['FunctionStatus']:
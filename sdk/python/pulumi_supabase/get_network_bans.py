@@ -0,0 +1,87 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = [
+    'GetNetworkBansResult',
+    'AwaitableGetNetworkBansResult',
+    'get_network_bans',
+    'get_network_bans_output',
+]
+
+@pulumi.output_type
+class GetNetworkBansResult:
+    def __init__(__self__, banned_ipv4_addresses=None, project_id=None):
+        if banned_ipv4_addresses and not isinstance(banned_ipv4_addresses, list):
+            raise TypeError("Expected argument 'banned_ipv4_addresses' to be a list")
+        pulumi.set(__self__, "banned_ipv4_addresses", banned_ipv4_addresses)
+        if project_id and not isinstance(project_id, str):
+            raise TypeError("Expected argument 'project_id' to be a str")
+        pulumi.set(__self__, "project_id", project_id)
+
+    @property
+    @pulumi.getter(name="bannedIpv4Addresses")
+    def banned_ipv4_addresses(self) -> Sequence[str]:
+        """
+        Banned IPv4 addresses
+        """
+        return pulumi.get(self, "banned_ipv4_addresses")
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> str:
+        """
+        ID of the project
+        """
+        return pulumi.get(self, "project_id")
+
+
+class AwaitableGetNetworkBansResult(GetNetworkBansResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetNetworkBansResult(
+            banned_ipv4_addresses=self.banned_ipv4_addresses,
+            project_id=self.project_id)
+
+
+def get_network_bans(project_id: Optional[str] = None,
+                     opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetNetworkBansResult:
+    """
+    Lists the IPv4 addresses banned by the database of a project
+
+
+    :param str project_id: ID of the project
+    """
+    __args__ = dict()
+    __args__['projectId'] = project_id
+    if opts is None:
+        opts = pulumi.InvokeOptions()
+    if opts.version is None:
+        opts.version = _utilities.get_version()
+        if opts.plugin_download_url is None:
+            opts.plugin_download_url = _utilities.get_plugin_download_url()
+    __ret__ = pulumi.runtime.invoke('supabase:index:getNetworkBans', __args__, opts=opts, typ=GetNetworkBansResult).value
+
+    return AwaitableGetNetworkBansResult(
+        banned_ipv4_addresses=__ret__.banned_ipv4_addresses,
+        project_id=__ret__.project_id)
+
+
+@_utilities.lift_output_func(get_network_bans)
+def get_network_bans_output(project_id: Optional[pulumi.Input[str]] = None,
+                            opts: Optional[pulumi.InvokeOptions] = None) -> pulumi.Output[GetNetworkBansResult]:
+    """
+    Lists the IPv4 addresses banned by the database of a project
+
+
+    :param str project_id: ID of the project
+    """
+    ...
@@ -0,0 +1,197 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = ['NetworkBanRemovalArgs', 'NetworkBanRemoval']
+
+@pulumi.input_type
+class NetworkBanRemovalArgs:
+    def __init__(__self__, *,
+                 ipv4_addresses: pulumi.Input[Sequence[pulumi.Input[str]]],
+                 project_id: pulumi.Input[str],
+                 triggers: Optional[pulumi.Input[Sequence[Any]]] = None):
+        """
+        The set of arguments for constructing a NetworkBanRemoval resource.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] ipv4_addresses: IPv4 addresses whose bans are lifted
+        :param pulumi.Input[str] project_id: ID of the project
+        :param pulumi.Input[Sequence[Any]] triggers: Values which lift the bans again whenever they change, such as a deployment ID
+        """
+        pulumi.set(__self__, "ipv4_addresses", ipv4_addresses)
+        pulumi.set(__self__, "project_id", project_id)
+        if triggers is not None:
+            pulumi.set(__self__, "triggers", triggers)
+
+    @property
+    @pulumi.getter(name="ipv4Addresses")
+    def ipv4_addresses(self) -> pulumi.Input[Sequence[pulumi.Input[str]]]:
+        """
+        IPv4 addresses whose bans are lifted
+        """
+        return pulumi.get(self, "ipv4_addresses")
+
+    @ipv4_addresses.setter
+    def ipv4_addresses(self, value: pulumi.Input[Sequence[pulumi.Input[str]]]):
+        pulumi.set(self, "ipv4_addresses", value)
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Input[str]:
+        """
+        ID of the project
+        """
+        return pulumi.get(self, "project_id")
+
+    @project_id.setter
+    def project_id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "project_id", value)
+
+    @property
+    @pulumi.getter
+    def triggers(self) -> Optional[pulumi.Input[Sequence[Any]]]:
+        """
+        Values which lift the bans again whenever they change, such as a deployment ID
+        """
+        return pulumi.get(self, "triggers")
+
+    @triggers.setter
+    def triggers(self, value: Optional[pulumi.Input[Sequence[Any]]]):
+        pulumi.set(self, "triggers", value)
+
+
+class NetworkBanRemoval(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 ipv4_addresses: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 triggers: Optional[pulumi.Input[Sequence[Any]]] = None,
+                 __props__=None):
+        """
+        Lifts the network bans of a set of IPv4 addresses of a project, the database bans addresses after repeated
+        failed connections. The bans are lifted on creation and again whenever the addresses or the triggers change,
+        deleting the resource does not ban the addresses again.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] ipv4_addresses: IPv4 addresses whose bans are lifted
+        :param pulumi.Input[str] project_id: ID of the project
+        :param pulumi.Input[Sequence[Any]] triggers: Values which lift the bans again whenever they change, such as a deployment ID
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: NetworkBanRemovalArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Lifts the network bans of a set of IPv4 addresses of a project, the database bans addresses after repeated
+        failed connections. The bans are lifted on creation and again whenever the addresses or the triggers change,
+        deleting the resource does not ban the addresses again.
+
+        :param str resource_name: The name of the resource.
+        :param NetworkBanRemovalArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(NetworkBanRemovalArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 ipv4_addresses: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 triggers: Optional[pulumi.Input[Sequence[Any]]] = None,
+                 __props__=None):
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.plugin_download_url is None:
+            opts.plugin_download_url = _utilities.get_plugin_download_url()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = NetworkBanRemovalArgs.__new__(NetworkBanRemovalArgs)
+
+            if ipv4_addresses is None and not opts.urn:
+                raise TypeError("Missing required property 'ipv4_addresses'")
+            __props__.__dict__["ipv4_addresses"] = ipv4_addresses
+            if project_id is None and not opts.urn:
+                raise TypeError("Missing required property 'project_id'")
+            __props__.__dict__["project_id"] = project_id
+            __props__.__dict__["triggers"] = triggers
+            __props__.__dict__["unbanned_ipv4_addresses"] = None
+        super(NetworkBanRemoval, __self__).__init__(
+            'supabase:index:NetworkBanRemoval',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'NetworkBanRemoval':
+        """
+        Get an existing NetworkBanRemoval resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = NetworkBanRemovalArgs.__new__(NetworkBanRemovalArgs)
+
+        __props__.__dict__["ipv4_addresses"] = None
+        __props__.__dict__["project_id"] = None
+        __props__.__dict__["triggers"] = None
+        __props__.__dict__["unbanned_ipv4_addresses"] = None
+        return NetworkBanRemoval(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="ipv4Addresses")
+    def ipv4_addresses(self) -> pulumi.Output[Sequence[str]]:
+        """
+        IPv4 addresses whose bans are lifted
+        """
+        return pulumi.get(self, "ipv4_addresses")
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Output[str]:
+        """
+        ID of the project
+        """
+        return pulumi.get(self, "project_id")
+
+    @property
+    @pulumi.getter
+    def triggers(self) -> pulumi.Output[Optional[Sequence[Any]]]:
+        """
+        Values which lift the bans again whenever they change, such as a deployment ID
+        """
+        return pulumi.get(self, "triggers")
+
+    @property
+    @pulumi.getter(name="unbannedIpv4Addresses")
+    def unbanned_ipv4_addresses(self) -> pulumi.Output[Sequence[str]]:
+        """
+        Addresses which were banned when the bans were last lifted
+        """
+        return pulumi.get(self, "unbanned_ipv4_addresses")
+
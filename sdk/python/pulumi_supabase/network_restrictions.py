@@ -0,0 +1,180 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = ['NetworkRestrictionsArgs', 'NetworkRestrictions']
+
+@pulumi.input_type
+class NetworkRestrictionsArgs:
+    def __init__(__self__, *,
+                 db_allowed_cidrs: pulumi.Input[Sequence[pulumi.Input[str]]],
+                 project_id: pulumi.Input[str]):
+        """
+        The set of arguments for constructing a NetworkRestrictions resource.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] db_allowed_cidrs: IPv4 and IPv6 CIDRs allowed to connect to the database, they must not overlap
+        :param pulumi.Input[str] project_id: ID of the project
+        """
+        pulumi.set(__self__, "db_allowed_cidrs", db_allowed_cidrs)
+        pulumi.set(__self__, "project_id", project_id)
+
+    @property
+    @pulumi.getter(name="dbAllowedCidrs")
+    def db_allowed_cidrs(self) -> pulumi.Input[Sequence[pulumi.Input[str]]]:
+        """
+        IPv4 and IPv6 CIDRs allowed to connect to the database, they must not overlap
+        """
+        return pulumi.get(self, "db_allowed_cidrs")
+
+    @db_allowed_cidrs.setter
+    def db_allowed_cidrs(self, value: pulumi.Input[Sequence[pulumi.Input[str]]]):
+        pulumi.set(self, "db_allowed_cidrs", value)
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Input[str]:
+        """
+        ID of the project
+        """
+        return pulumi.get(self, "project_id")
+
+    @project_id.setter
+    def project_id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "project_id", value)
+
+
+class NetworkRestrictions(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 db_allowed_cidrs: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        Network restrictions of the database of a project. Deleting the resource allows every address again.
+
+        The restrictions of a project can be imported with its reference:
+        `pulumi import supabase:index:NetworkRestrictions db <projectRef>`
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] db_allowed_cidrs: IPv4 and IPv6 CIDRs allowed to connect to the database, they must not overlap
+        :param pulumi.Input[str] project_id: ID of the project
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: NetworkRestrictionsArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Network restrictions of the database of a project. Deleting the resource allows every address again.
+
+        The restrictions of a project can be imported with its reference:
+        `pulumi import supabase:index:NetworkRestrictions db <projectRef>`
+
+        :param str resource_name: The name of the resource.
+        :param NetworkRestrictionsArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(NetworkRestrictionsArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 db_allowed_cidrs: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.plugin_download_url is None:
+            opts.plugin_download_url = _utilities.get_plugin_download_url()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = NetworkRestrictionsArgs.__new__(NetworkRestrictionsArgs)
+
+            if db_allowed_cidrs is None and not opts.urn:
+                raise TypeError("Missing required property 'db_allowed_cidrs'")
+            __props__.__dict__["db_allowed_cidrs"] = db_allowed_cidrs
+            if project_id is None and not opts.urn:
+                raise TypeError("Missing required property 'project_id'")
+            __props__.__dict__["project_id"] = project_id
+            __props__.__dict__["entitlement"] = None
+            __props__.__dict__["status"] = None
+        super(NetworkRestrictions, __self__).__init__(
+            'supabase:index:NetworkRestrictions',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'NetworkRestrictions':
+        """
+        Get an existing NetworkRestrictions resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = NetworkRestrictionsArgs.__new__(NetworkRestrictionsArgs)
+
+        __props__.__dict__["db_allowed_cidrs"] = None
+        __props__.__dict__["entitlement"] = None
+        __props__.__dict__["project_id"] = None
+        __props__.__dict__["status"] = None
+        return NetworkRestrictions(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="dbAllowedCidrs")
+    def db_allowed_cidrs(self) -> pulumi.Output[Sequence[str]]:
+        """
+        IPv4 and IPv6 CIDRs allowed to connect to the database, they must not overlap
+        """
+        return pulumi.get(self, "db_allowed_cidrs")
+
+    @property
+    @pulumi.getter
+    def entitlement(self) -> pulumi.Output[str]:
+        """
+        Whether the plan of the project allows network restrictions (allowed or disallowed)
+        """
+        return pulumi.get(self, "entitlement")
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Output[str]:
+        """
+        ID of the project
+        """
+        return pulumi.get(self, "project_id")
+
+    @property
+    @pulumi.getter
+    def status(self) -> pulumi.Output[str]:
+        """
+        Whether the restrictions are applied or only stored
+        """
+        return pulumi.get(self, "status")
+
@@ -0,0 +1,399 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from . import outputs
+from ._enums import *
+
+__all__ = [
+    'DnsRecord',
+    'ProjectAuthEmail',
+    'ProjectAuthExternalProvider',
+    'ProjectAuthSms',
+    'ProjectAuthSmtp',
+    'ProjectAuthTwilio',
+]
+
+@pulumi.output_type
+class DnsRecord(dict):
+    """
+    DNS record to create at the DNS provider of a hostname
+    """
+    def __init__(__self__, *,
+                 name: str,
+                 type: str,
+                 value: str):
+        """
+        DNS record to create at the DNS provider of a hostname
+        :param str name: Name of the record
+        :param str type: Type of the record (CNAME or TXT)
+        :param str value: Value of the record
+        """
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "type", type)
+        pulumi.set(__self__, "value", value)
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        """
+        Name of the record
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter
+    def type(self) -> str:
+        """
+        Type of the record (CNAME or TXT)
+        """
+        return pulumi.get(self, "type")
+
+    @property
+    @pulumi.getter
+    def value(self) -> str:
+        """
+        Value of the record
+        """
+        return pulumi.get(self, "value")
+
+
+@pulumi.output_type
+class ProjectAuthEmail(dict):
+    def __init__(__self__, *,
+                 autoconfirm: Optional[bool] = None,
+                 enabled: Optional[bool] = None,
+                 smtp: Optional['outputs.ProjectAuthSmtp'] = None):
+        """
+        :param bool autoconfirm: Confirm the email of new users without sending a confirmation email
+        :param bool enabled: Allow users to sign up and sign in with their email
+        :param 'ProjectAuthSmtp' smtp: Custom SMTP server the auth emails are sent with
+        """
+        if autoconfirm is not None:
+            pulumi.set(__self__, "autoconfirm", autoconfirm)
+        if enabled is not None:
+            pulumi.set(__self__, "enabled", enabled)
+        if smtp is not None:
+            pulumi.set(__self__, "smtp", smtp)
+
+    @property
+    @pulumi.getter
+    def autoconfirm(self) -> Optional[bool]:
+        """
+        Confirm the email of new users without sending a confirmation email
+        """
+        return pulumi.get(self, "autoconfirm")
+
+    @property
+    @pulumi.getter
+    def enabled(self) -> Optional[bool]:
+        """
+        Allow users to sign up and sign in with their email
+        """
+        return pulumi.get(self, "enabled")
+
+    @property
+    @pulumi.getter
+    def smtp(self) -> Optional['outputs.ProjectAuthSmtp']:
+        """
+        Custom SMTP server the auth emails are sent with
+        """
+        return pulumi.get(self, "smtp")
+
+
+@pulumi.output_type
+class ProjectAuthExternalProvider(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "clientId":
+            suggest = "client_id"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in ProjectAuthExternalProvider. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        ProjectAuthExternalProvider.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        ProjectAuthExternalProvider.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 client_id: Optional[str] = None,
+                 enabled: Optional[bool] = None,
+                 secret: Optional[str] = None,
+                 url: Optional[str] = None):
+        """
+        :param str client_id: OAuth client ID
+        :param bool enabled: Allow users to sign in with the provider
+        :param str secret: OAuth client secret
+        :param str url: URL of the provider, for the self-hosted ones (azure, gitlab, keycloak, workos)
+        """
+        if client_id is not None:
+            pulumi.set(__self__, "client_id", client_id)
+        if enabled is not None:
+            pulumi.set(__self__, "enabled", enabled)
+        if secret is not None:
+            pulumi.set(__self__, "secret", secret)
+        if url is not None:
+            pulumi.set(__self__, "url", url)
+
+    @property
+    @pulumi.getter(name="clientId")
+    def client_id(self) -> Optional[str]:
+        """
+        OAuth client ID
+        """
+        return pulumi.get(self, "client_id")
+
+    @property
+    @pulumi.getter
+    def enabled(self) -> Optional[bool]:
+        """
+        Allow users to sign in with the provider
+        """
+        return pulumi.get(self, "enabled")
+
+    @property
+    @pulumi.getter
+    def secret(self) -> Optional[str]:
+        """
+        OAuth client secret
+        """
+        return pulumi.get(self, "secret")
+
+    @property
+    @pulumi.getter
+    def url(self) -> Optional[str]:
+        """
+        URL of the provider, for the self-hosted ones (azure, gitlab, keycloak, workos)
+        """
+        return pulumi.get(self, "url")
+
+
+@pulumi.output_type
+class ProjectAuthSms(dict):
+    def __init__(__self__, *,
+                 autoconfirm: Optional[bool] = None,
+                 enabled: Optional[bool] = None,
+                 provider: Optional[str] = None,
+                 twilio: Optional['outputs.ProjectAuthTwilio'] = None):
+        """
+        :param bool autoconfirm: Confirm the phone number of new users without sending a confirmation SMS
+        :param bool enabled: Allow users to sign up and sign in with their phone number
+        :param str provider: SMS provider (twilio, twilio_verify, messagebird, textlocal or vonage)
+        :param 'ProjectAuthTwilio' twilio: Twilio credentials, used by the twilio provider
+        """
+        if autoconfirm is not None:
+            pulumi.set(__self__, "autoconfirm", autoconfirm)
+        if enabled is not None:
+            pulumi.set(__self__, "enabled", enabled)
+        if provider is not None:
+            pulumi.set(__self__, "provider", provider)
+        if twilio is not None:
+            pulumi.set(__self__, "twilio", twilio)
+
+    @property
+    @pulumi.getter
+    def autoconfirm(self) -> Optional[bool]:
+        """
+        Confirm the phone number of new users without sending a confirmation SMS
+        """
+        return pulumi.get(self, "autoconfirm")
+
+    @property
+    @pulumi.getter
+    def enabled(self) -> Optional[bool]:
+        """
+        Allow users to sign up and sign in with their phone number
+        """
+        return pulumi.get(self, "enabled")
+
+    @property
+    @pulumi.getter
+    def provider(self) -> Optional[str]:
+        """
+        SMS provider (twilio, twilio_verify, messagebird, textlocal or vonage)
+        """
+        return pulumi.get(self, "provider")
+
+    @property
+    @pulumi.getter
+    def twilio(self) -> Optional['outputs.ProjectAuthTwilio']:
+        """
+        Twilio credentials, used by the twilio provider
+        """
+        return pulumi.get(self, "twilio")
+
+
+@pulumi.output_type
+class ProjectAuthSmtp(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "adminEmail":
+            suggest = "admin_email"
+        elif key == "pass":
+            suggest = "pass_"
+        elif key == "senderName":
+            suggest = "sender_name"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in ProjectAuthSmtp. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        ProjectAuthSmtp.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        ProjectAuthSmtp.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 admin_email: Optional[str] = None,
+                 host: Optional[str] = None,
+                 pass_: Optional[str] = None,
+                 port: Optional[int] = None,
+                 sender_name: Optional[str] = None,
+                 user: Optional[str] = None):
+        """
+        :param str admin_email: Email address the auth emails are sent from
+        :param str host: Hostname of the SMTP server
+        :param str pass_: Password of the SMTP server
+        :param int port: Port of the SMTP server
+        :param str sender_name: Name the auth emails are sent from
+        :param str user: Username of the SMTP server
+        """
+        if admin_email is not None:
+            pulumi.set(__self__, "admin_email", admin_email)
+        if host is not None:
+            pulumi.set(__self__, "host", host)
+        if pass_ is not None:
+            pulumi.set(__self__, "pass_", pass_)
+        if port is not None:
+            pulumi.set(__self__, "port", port)
+        if sender_name is not None:
+            pulumi.set(__self__, "sender_name", sender_name)
+        if user is not None:
+            pulumi.set(__self__, "user", user)
+
+    @property
+    @pulumi.getter(name="adminEmail")
+    def admin_email(self) -> Optional[str]:
+        """
+        Email address the auth emails are sent from
+        """
+        return pulumi.get(self, "admin_email")
+
+    @property
+    @pulumi.getter
+    def host(self) -> Optional[str]:
+        """
+        Hostname of the SMTP server
+        """
+        return pulumi.get(self, "host")
+
+    @property
+    @pulumi.getter(name="pass")
+    def pass_(self) -> Optional[str]:
+        """
+        Password of the SMTP server
+        """
+        return pulumi.get(self, "pass_")
+
+    @property
+    @pulumi.getter
+    def port(self) -> Optional[int]:
+        """
+        Port of the SMTP server
+        """
+        return pulumi.get(self, "port")
+
+    @property
+    @pulumi.getter(name="senderName")
+    def sender_name(self) -> Optional[str]:
+        """
+        Name the auth emails are sent from
+        """
+        return pulumi.get(self, "sender_name")
+
+    @property
+    @pulumi.getter
+    def user(self) -> Optional[str]:
+        """
+        Username of the SMTP server
+        """
+        return pulumi.get(self, "user")
+
+
+@pulumi.output_type
+class ProjectAuthTwilio(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "accountSid":
+            suggest = "account_sid"
+        elif key == "authToken":
+            suggest = "auth_token"
+        elif key == "messageServiceSid":
+            suggest = "message_service_sid"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in ProjectAuthTwilio. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        ProjectAuthTwilio.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        ProjectAuthTwilio.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 account_sid: Optional[str] = None,
+                 auth_token: Optional[str] = None,
+                 message_service_sid: Optional[str] = None):
+        """
+        :param str account_sid: Twilio account SID
+        :param str auth_token: Twilio auth token
+        :param str message_service_sid: Twilio message service SID
+        """
+        if account_sid is not None:
+            pulumi.set(__self__, "account_sid", account_sid)
+        if auth_token is not None:
+            pulumi.set(__self__, "auth_token", auth_token)
+        if message_service_sid is not None:
+            pulumi.set(__self__, "message_service_sid", message_service_sid)
+
+    @property
+    @pulumi.getter(name="accountSid")
+    def account_sid(self) -> Optional[str]:
+        """
+        Twilio account SID
+        """
+        return pulumi.get(self, "account_sid")
+
+    @property
+    @pulumi.getter(name="authToken")
+    def auth_token(self) -> Optional[str]:
+        """
+        Twilio auth token
+        """
+        return pulumi.get(self, "auth_token")
+
+    @property
+    @pulumi.getter(name="messageServiceSid")
+    def message_service_sid(self) -> Optional[str]:
+        """
+        Twilio message service SID
+        """
+        return pulumi.get(self, "message_service_sid")
+
+
@@ -0,0 +1,219 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = ['PostgrestConfigArgs', 'PostgrestConfig']
+
+@pulumi.input_type
+class PostgrestConfigArgs:
+    def __init__(__self__, *,
+                 project_id: pulumi.Input[str],
+                 db_extra_search_path: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 db_schema: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 max_rows: Optional[pulumi.Input[int]] = None):
+        """
+        The set of arguments for constructing a PostgrestConfig resource.
+        :param pulumi.Input[str] project_id: ID of the project
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] db_extra_search_path: Schemas added to the search path of every request (public and extensions by default)
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] db_schema: Schemas exposed by the API (public, storage and graphql_public by default)
+        :param pulumi.Input[int] max_rows: Maximum number of rows returned by a request (1000 by default)
+        """
+        pulumi.set(__self__, "project_id", project_id)
+        if db_extra_search_path is not None:
+            pulumi.set(__self__, "db_extra_search_path", db_extra_search_path)
+        if db_schema is not None:
+            pulumi.set(__self__, "db_schema", db_schema)
+        if max_rows is not None:
+            pulumi.set(__self__, "max_rows", max_rows)
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Input[str]:
+        """
+        ID of the project
+        """
+        return pulumi.get(self, "project_id")
+
+    @project_id.setter
+    def project_id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "project_id", value)
+
+    @property
+    @pulumi.getter(name="dbExtraSearchPath")
+    def db_extra_search_path(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Schemas added to the search path of every request (public and extensions by default)
+        """
+        return pulumi.get(self, "db_extra_search_path")
+
+    @db_extra_search_path.setter
+    def db_extra_search_path(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "db_extra_search_path", value)
+
+    @property
+    @pulumi.getter(name="dbSchema")
+    def db_schema(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Schemas exposed by the API (public, storage and graphql_public by default)
+        """
+        return pulumi.get(self, "db_schema")
+
+    @db_schema.setter
+    def db_schema(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "db_schema", value)
+
+    @property
+    @pulumi.getter(name="maxRows")
+    def max_rows(self) -> Optional[pulumi.Input[int]]:
+        """
+        Maximum number of rows returned by a request (1000 by default)
+        """
+        return pulumi.get(self, "max_rows")
+
+    @max_rows.setter
+    def max_rows(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "max_rows", value)
+
+
+class PostgrestConfig(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 db_extra_search_path: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 db_schema: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 max_rows: Optional[pulumi.Input[int]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        PostgREST (Data API) configuration of a project. Only the settings given are managed, the others are left as is,
+        and deleting the resource restores the settings of a new project.
+
+        The configuration of a project can be imported with its reference:
+        `pulumi import supabase:index:PostgrestConfig api <projectRef>`
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] db_extra_search_path: Schemas added to the search path of every request (public and extensions by default)
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] db_schema: Schemas exposed by the API (public, storage and graphql_public by default)
+        :param pulumi.Input[int] max_rows: Maximum number of rows returned by a request (1000 by default)
+        :param pulumi.Input[str] project_id: ID of the project
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: PostgrestConfigArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        PostgREST (Data API) configuration of a project. Only the settings given are managed, the others are left as is,
+        and deleting the resource restores the settings of a new project.
+
+        The configuration of a project can be imported with its reference:
+        `pulumi import supabase:index:PostgrestConfig api <projectRef>`
+
+        :param str resource_name: The name of the resource.
+        :param PostgrestConfigArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(PostgrestConfigArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 db_extra_search_path: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 db_schema: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 max_rows: Optional[pulumi.Input[int]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.plugin_download_url is None:
+            opts.plugin_download_url = _utilities.get_plugin_download_url()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = PostgrestConfigArgs.__new__(PostgrestConfigArgs)
+
+            __props__.__dict__["db_extra_search_path"] = db_extra_search_path
+            __props__.__dict__["db_schema"] = db_schema
+            __props__.__dict__["max_rows"] = max_rows
+            if project_id is None and not opts.urn:
+                raise TypeError("Missing required property 'project_id'")
+            __props__.__dict__["project_id"] = project_id
+        super(PostgrestConfig, __self__).__init__(
+            'supabase:index:PostgrestConfig',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'PostgrestConfig':
+        """
+        Get an existing PostgrestConfig resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = PostgrestConfigArgs.__new__(PostgrestConfigArgs)
+
+        __props__.__dict__["db_extra_search_path"] = None
+        __props__.__dict__["db_schema"] = None
+        __props__.__dict__["max_rows"] = None
+        __props__.__dict__["project_id"] = None
+        return PostgrestConfig(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="dbExtraSearchPath")
+    def db_extra_search_path(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Schemas added to the search path of every request (public and extensions by default)
+        """
+        return pulumi.get(self, "db_extra_search_path")
+
+    @property
+    @pulumi.getter(name="dbSchema")
+    def db_schema(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Schemas exposed by the API (public, storage and graphql_public by default)
+        """
+        return pulumi.get(self, "db_schema")
+
+    @property
+    @pulumi.getter(name="maxRows")
+    def max_rows(self) -> pulumi.Output[Optional[int]]:
+        """
+        Maximum number of rows returned by a request (1000 by default)
+        """
+        return pulumi.get(self, "max_rows")
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Output[str]:
+        """
+        ID of the project
+        """
+        return pulumi.get(self, "project_id")
+
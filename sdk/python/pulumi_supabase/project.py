@@ -19,7 +19,8 @@ class ProjectArgs:
                  name: pulumi.Input[str],
                  organization_id: pulumi.Input[str],
                  plan: pulumi.Input['Plan'],
-                 region: pulumi.Input['Region']):
+                 region: pulumi.Input['Region'],
+                 deletion_protection: Optional[pulumi.Input[bool]] = None):
         """
         The set of arguments for constructing a Project resource.
         :param pulumi.Input[str] db_pass: Postgres password of the project
@@ -28,6 +29,7 @@ class ProjectArgs:
         :param pulumi.Input[str] organization_id: Organization ID of the project
         :param pulumi.Input['Plan'] plan: Plan of the project
         :param pulumi.Input['Region'] region: Region of the project
+        :param pulumi.Input[bool] deletion_protection: Refuse to delete the project while enabled, set to false to allow `pulumi destroy` or replacement
         """
         pulumi.set(__self__, "db_pass", db_pass)
         pulumi.set(__self__, "kps_enabled", kps_enabled)
@@ -35,6 +37,10 @@ class ProjectArgs:
         pulumi.set(__self__, "organization_id", organization_id)
         pulumi.set(__self__, "plan", plan)
         pulumi.set(__self__, "region", region)
+        if deletion_protection is None:
+            deletion_protection = True
+        if deletion_protection is not None:
+            pulumi.set(__self__, "deletion_protection", deletion_protection)
 
     @property
     @pulumi.getter
@@ -108,6 +114,18 @@ class ProjectArgs:
     def region(self, value: pulumi.Input['Region']):
         pulumi.set(self, "region", value)
 
+    @property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Refuse to delete the project while enabled, set to false to allow `pulumi destroy` or replacement
+        """
+        return pulumi.get(self, "deletion_protection")
+
+    @deletion_protection.setter
+    def deletion_protection(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "deletion_protection", value)
+
 
 class Project(pulumi.CustomResource):
     @overload
@@ -115,6 +133,7 @@ class Project(pulumi.CustomResource):
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
                  db_pass: Optional[pulumi.Input[str]] = None,
+                 deletion_protection: Optional[pulumi.Input[bool]] = None,
                  kps_enabled: Optional[pulumi.Input[bool]] = None,
                  name: Optional[pulumi.Input[str]] = None,
                  organization_id: Optional[pulumi.Input[str]] = None,
@@ -122,10 +141,19 @@ class Project(pulumi.CustomResource):
                  region: Optional[pulumi.Input['Region']] = None,
                  __props__=None):
         """
-        Create a Project resource with the given unique name, props, and options.
+        Supabase project.
+
+        Existing projects can be imported with their reference:
+        `pulumi import supabase:index:Project my-project <projectRef>`
+        The API does not return the database password and KPS setting of a project, and only some versions of it return
+        its plan. After an import, the first update records the plan and KPS setting of the program without changing the
+        project. A plan change fails the update when the plan read back from the API is not the new one. The password is only
+        recorded along with another update, until then changing it does not replace the project.
+
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
         :param pulumi.Input[str] db_pass: Postgres password of the project
+        :param pulumi.Input[bool] deletion_protection: Refuse to delete the project while enabled, set to false to allow `pulumi destroy` or replacement
         :param pulumi.Input[bool] kps_enabled: KPS Enabled on the project
         :param pulumi.Input[str] name: Name of the project
         :param pulumi.Input[str] organization_id: Organization ID of the project
@@ -139,7 +167,15 @@ class Project(pulumi.CustomResource):
                  args: ProjectArgs,
                  opts: Optional[pulumi.ResourceOptions] = None):
         """
-        Create a Project resource with the given unique name, props, and options.
+        Supabase project.
+
+        Existing projects can be imported with their reference:
+        `pulumi import supabase:index:Project my-project <projectRef>`
+        The API does not return the database password and KPS setting of a project, and only some versions of it return
+        its plan. After an import, the first update records the plan and KPS setting of the program without changing the
+        project. A plan change fails the update when the plan read back from the API is not the new one. The password is only
+        recorded along with another update, until then changing it does not replace the project.
+
         :param str resource_name: The name of the resource.
         :param ProjectArgs args: The arguments to use to populate this resource's properties.
         :param pulumi.ResourceOptions opts: Options for the resource.
@@ -156,6 +192,7 @@ class Project(pulumi.CustomResource):
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
                  db_pass: Optional[pulumi.Input[str]] = None,
+                 deletion_protection: Optional[pulumi.Input[bool]] = None,
                  kps_enabled: Optional[pulumi.Input[bool]] = None,
                  name: Optional[pulumi.Input[str]] = None,
                  organization_id: Optional[pulumi.Input[str]] = None,
@@ -178,6 +215,9 @@ class Project(pulumi.CustomResource):
             if db_pass is None and not opts.urn:
                 raise TypeError("Missing required property 'db_pass'")
             __props__.__dict__["db_pass"] = None if db_pass is None else pulumi.Output.secret(db_pass)
+            if deletion_protection is None:
+                deletion_protection = True
+            __props__.__dict__["deletion_protection"] = deletion_protection
             if kps_enabled is None and not opts.urn:
                 raise TypeError("Missing required property 'kps_enabled'")
             __props__.__dict__["kps_enabled"] = kps_enabled
@@ -200,6 +240,7 @@ class Project(pulumi.CustomResource):
             __props__.__dict__["db_port"] = None
             __props__.__dict__["db_username"] = None
             __props__.__dict__["endpoint"] = None
+            __props__.__dict__["status"] = None
         super(Project, __self__).__init__(
             'supabase:index:Project',
             resource_name,
@@ -228,10 +269,14 @@ class Project(pulumi.CustomResource):
         __props__.__dict__["db_pooling_port"] = None
         __props__.__dict__["db_port"] = None
         __props__.__dict__["db_username"] = None
+        __props__.__dict__["deletion_protection"] = None
         __props__.__dict__["endpoint"] = None
+        __props__.__dict__["kps_enabled"] = None
         __props__.__dict__["name"] = None
         __props__.__dict__["organization_id"] = None
+        __props__.__dict__["plan"] = None
         __props__.__dict__["region"] = None
+        __props__.__dict__["status"] = None
         return Project(resource_name, opts=opts, __props__=__props__)
 
     @property
@@ -282,6 +327,14 @@ class Project(pulumi.CustomResource):
         """
         return pulumi.get(self, "db_username")
 
+    @property
+    @pulumi.getter(name="deletionProtection")
+    def deletion_protection(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Refuse to delete the project while enabled
+        """
+        return pulumi.get(self, "deletion_protection")
+
     @property
     @pulumi.getter
     def endpoint(self) -> pulumi.Output[str]:
@@ -290,6 +343,14 @@ class Project(pulumi.CustomResource):
         """
         return pulumi.get(self, "endpoint")
 
+    @property
+    @pulumi.getter
+    def kps_enabled(self) -> pulumi.Output[Optional[bool]]:
+        """
+        KPS Enabled on the project
+        """
+        return pulumi.get(self, "kps_enabled")
+
     @property
     @pulumi.getter
     def name(self) -> pulumi.Output[str]:
@@ -306,6 +367,14 @@ class Project(pulumi.CustomResource):
         """
         return pulumi.get(self, "organization_id")
 
+    @property
+    @pulumi.getter
+    def plan(self) -> pulumi.Output[Optional['Plan']]:
+        """
+        Plan of the project
+        """
+        return pulumi.get(self, "plan")
+
     @property
     @pulumi.getter
     def region(self) -> pulumi.Output['Region']:
@@ -314,3 +383,11 @@ class Project(pulumi.CustomResource):
         """
         return pulumi.get(self, "region")
 
+    @property
+    @pulumi.getter
+    def status(self) -> pulumi.Output[Optional[str]]:
+        """
+        Provisioning status of the project (e.g. ACTIVE_HEALTHY)
+        """
+        return pulumi.get(self, "status")
+
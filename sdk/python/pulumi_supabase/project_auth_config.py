@@ -0,0 +1,337 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['ProjectAuthConfigArgs', 'ProjectAuthConfig']
+
+@pulumi.input_type
+class ProjectAuthConfigArgs:
+    def __init__(__self__, *,
+                 project_id: pulumi.Input[str],
+                 additional_redirect_urls: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 disable_signup: Optional[pulumi.Input[bool]] = None,
+                 email: Optional[pulumi.Input['ProjectAuthEmailArgs']] = None,
+                 external_providers: Optional[pulumi.Input[Mapping[str, pulumi.Input['ProjectAuthExternalProviderArgs']]]] = None,
+                 jwt_expiry: Optional[pulumi.Input[int]] = None,
+                 site_url: Optional[pulumi.Input[str]] = None,
+                 sms: Optional[pulumi.Input['ProjectAuthSmsArgs']] = None):
+        """
+        The set of arguments for constructing a ProjectAuthConfig resource.
+        :param pulumi.Input[str] project_id: ID of the project
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] additional_redirect_urls: Other URLs users may be redirected to after signing in
+        :param pulumi.Input[bool] disable_signup: Refuse the sign up of new users
+        :param pulumi.Input['ProjectAuthEmailArgs'] email: Email sign in settings
+        :param pulumi.Input[Mapping[str, pulumi.Input['ProjectAuthExternalProviderArgs']]] external_providers: OAuth providers by name (apple, azure, github, google, ...)
+        :param pulumi.Input[int] jwt_expiry: Lifetime of the access tokens in seconds (up to 604800)
+        :param pulumi.Input[str] site_url: URL users are redirected to after signing in when no redirect URL is given
+        :param pulumi.Input['ProjectAuthSmsArgs'] sms: Phone sign in settings
+        """
+        pulumi.set(__self__, "project_id", project_id)
+        if additional_redirect_urls is not None:
+            pulumi.set(__self__, "additional_redirect_urls", additional_redirect_urls)
+        if disable_signup is not None:
+            pulumi.set(__self__, "disable_signup", disable_signup)
+        if email is not None:
+            pulumi.set(__self__, "email", email)
+        if external_providers is not None:
+            pulumi.set(__self__, "external_providers", external_providers)
+        if jwt_expiry is not None:
+            pulumi.set(__self__, "jwt_expiry", jwt_expiry)
+        if site_url is not None:
+            pulumi.set(__self__, "site_url", site_url)
+        if sms is not None:
+            pulumi.set(__self__, "sms", sms)
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Input[str]:
+        """
+        ID of the project
+        """
+        return pulumi.get(self, "project_id")
+
+    @project_id.setter
+    def project_id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "project_id", value)
+
+    @property
+    @pulumi.getter(name="additionalRedirectUrls")
+    def additional_redirect_urls(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Other URLs users may be redirected to after signing in
+        """
+        return pulumi.get(self, "additional_redirect_urls")
+
+    @additional_redirect_urls.setter
+    def additional_redirect_urls(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "additional_redirect_urls", value)
+
+    @property
+    @pulumi.getter(name="disableSignup")
+    def disable_signup(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Refuse the sign up of new users
+        """
+        return pulumi.get(self, "disable_signup")
+
+    @disable_signup.setter
+    def disable_signup(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "disable_signup", value)
+
+    @property
+    @pulumi.getter
+    def email(self) -> Optional[pulumi.Input['ProjectAuthEmailArgs']]:
+        """
+        Email sign in settings
+        """
+        return pulumi.get(self, "email")
+
+    @email.setter
+    def email(self, value: Optional[pulumi.Input['ProjectAuthEmailArgs']]):
+        pulumi.set(self, "email", value)
+
+    @property
+    @pulumi.getter(name="externalProviders")
+    def external_providers(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input['ProjectAuthExternalProviderArgs']]]]:
+        """
+        OAuth providers by name (apple, azure, github, google, ...)
+        """
+        return pulumi.get(self, "external_providers")
+
+    @external_providers.setter
+    def external_providers(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input['ProjectAuthExternalProviderArgs']]]]):
+        pulumi.set(self, "external_providers", value)
+
+    @property
+    @pulumi.getter(name="jwtExpiry")
+    def jwt_expiry(self) -> Optional[pulumi.Input[int]]:
+        """
+        Lifetime of the access tokens in seconds (up to 604800)
+        """
+        return pulumi.get(self, "jwt_expiry")
+
+    @jwt_expiry.setter
+    def jwt_expiry(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "jwt_expiry", value)
+
+    @property
+    @pulumi.getter(name="siteUrl")
+    def site_url(self) -> Optional[pulumi.Input[str]]:
+        """
+        URL users are redirected to after signing in when no redirect URL is given
+        """
+        return pulumi.get(self, "site_url")
+
+    @site_url.setter
+    def site_url(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "site_url", value)
+
+    @property
+    @pulumi.getter
+    def sms(self) -> Optional[pulumi.Input['ProjectAuthSmsArgs']]:
+        """
+        Phone sign in settings
+        """
+        return pulumi.get(self, "sms")
+
+    @sms.setter
+    def sms(self, value: Optional[pulumi.Input['ProjectAuthSmsArgs']]):
+        pulumi.set(self, "sms", value)
+
+
+class ProjectAuthConfig(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 additional_redirect_urls: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 disable_signup: Optional[pulumi.Input[bool]] = None,
+                 email: Optional[pulumi.Input[pulumi.InputType['ProjectAuthEmailArgs']]] = None,
+                 external_providers: Optional[pulumi.Input[Mapping[str, pulumi.Input[pulumi.InputType['ProjectAuthExternalProviderArgs']]]]] = None,
+                 jwt_expiry: Optional[pulumi.Input[int]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 site_url: Optional[pulumi.Input[str]] = None,
+                 sms: Optional[pulumi.Input[pulumi.InputType['ProjectAuthSmsArgs']]] = None,
+                 __props__=None):
+        """
+        Auth configuration of a project. Only the settings given are managed, the others are left as is,
+        and deleting the resource leaves the configuration of the project untouched.
+
+        The configuration of a project can be imported with its reference, every setting is then adopted:
+        `pulumi import supabase:index:ProjectAuthConfig auth <projectRef>`
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] additional_redirect_urls: Other URLs users may be redirected to after signing in
+        :param pulumi.Input[bool] disable_signup: Refuse the sign up of new users
+        :param pulumi.Input[pulumi.InputType['ProjectAuthEmailArgs']] email: Email sign in settings
+        :param pulumi.Input[Mapping[str, pulumi.Input[pulumi.InputType['ProjectAuthExternalProviderArgs']]]] external_providers: OAuth providers by name (apple, azure, github, google, ...)
+        :param pulumi.Input[int] jwt_expiry: Lifetime of the access tokens in seconds (up to 604800)
+        :param pulumi.Input[str] project_id: ID of the project
+        :param pulumi.Input[str] site_url: URL users are redirected to after signing in when no redirect URL is given
+        :param pulumi.Input[pulumi.InputType['ProjectAuthSmsArgs']] sms: Phone sign in settings
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: ProjectAuthConfigArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Auth configuration of a project. Only the settings given are managed, the others are left as is,
+        and deleting the resource leaves the configuration of the project untouched.
+
+        The configuration of a project can be imported with its reference, every setting is then adopted:
+        `pulumi import supabase:index:ProjectAuthConfig auth <projectRef>`
+
+        :param str resource_name: The name of the resource.
+        :param ProjectAuthConfigArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(ProjectAuthConfigArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 additional_redirect_urls: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 disable_signup: Optional[pulumi.Input[bool]] = None,
+                 email: Optional[pulumi.Input[pulumi.InputType['ProjectAuthEmailArgs']]] = None,
+                 external_providers: Optional[pulumi.Input[Mapping[str, pulumi.Input[pulumi.InputType['ProjectAuthExternalProviderArgs']]]]] = None,
+                 jwt_expiry: Optional[pulumi.Input[int]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 site_url: Optional[pulumi.Input[str]] = None,
+                 sms: Optional[pulumi.Input[pulumi.InputType['ProjectAuthSmsArgs']]] = None,
+                 __props__=None):
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.plugin_download_url is None:
+            opts.plugin_download_url = _utilities.get_plugin_download_url()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = ProjectAuthConfigArgs.__new__(ProjectAuthConfigArgs)
+
+            __props__.__dict__["additional_redirect_urls"] = additional_redirect_urls
+            __props__.__dict__["disable_signup"] = disable_signup
+            __props__.__dict__["email"] = email
+            __props__.__dict__["external_providers"] = external_providers
+            __props__.__dict__["jwt_expiry"] = jwt_expiry
+            if project_id is None and not opts.urn:
+                raise TypeError("Missing required property 'project_id'")
+            __props__.__dict__["project_id"] = project_id
+            __props__.__dict__["site_url"] = site_url
+            __props__.__dict__["sms"] = sms
+        super(ProjectAuthConfig, __self__).__init__(
+            'supabase:index:ProjectAuthConfig',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'ProjectAuthConfig':
+        """
+        Get an existing ProjectAuthConfig resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = ProjectAuthConfigArgs.__new__(ProjectAuthConfigArgs)
+
+        __props__.__dict__["additional_redirect_urls"] = None
+        __props__.__dict__["disable_signup"] = None
+        __props__.__dict__["email"] = None
+        __props__.__dict__["external_providers"] = None
+        __props__.__dict__["jwt_expiry"] = None
+        __props__.__dict__["project_id"] = None
+        __props__.__dict__["site_url"] = None
+        __props__.__dict__["sms"] = None
+        return ProjectAuthConfig(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="additionalRedirectUrls")
+    def additional_redirect_urls(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Other URLs users may be redirected to after signing in
+        """
+        return pulumi.get(self, "additional_redirect_urls")
+
+    @property
+    @pulumi.getter(name="disableSignup")
+    def disable_signup(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Refuse the sign up of new users
+        """
+        return pulumi.get(self, "disable_signup")
+
+    @property
+    @pulumi.getter
+    def email(self) -> pulumi.Output[Optional['outputs.ProjectAuthEmail']]:
+        """
+        Email sign in settings
+        """
+        return pulumi.get(self, "email")
+
+    @property
+    @pulumi.getter(name="externalProviders")
+    def external_providers(self) -> pulumi.Output[Optional[Mapping[str, 'outputs.ProjectAuthExternalProvider']]]:
+        """
+        OAuth providers by name (apple, azure, github, google, ...)
+        """
+        return pulumi.get(self, "external_providers")
+
+    @property
+    @pulumi.getter(name="jwtExpiry")
+    def jwt_expiry(self) -> pulumi.Output[Optional[int]]:
+        """
+        Lifetime of the access tokens in seconds (up to 604800)
+        """
+        return pulumi.get(self, "jwt_expiry")
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Output[str]:
+        """
+        ID of the project
+        """
+        return pulumi.get(self, "project_id")
+
+    @property
+    @pulumi.getter(name="siteUrl")
+    def site_url(self) -> pulumi.Output[Optional[str]]:
+        """
+        URL users are redirected to after signing in when no redirect URL is given
+        """
+        return pulumi.get(self, "site_url")
+
+    @property
+    @pulumi.getter
+    def sms(self) -> pulumi.Output[Optional['outputs.ProjectAuthSms']]:
+        """
+        Phone sign in settings
+        """
+        return pulumi.get(self, "sms")
+
@@ -73,7 +73,11 @@ class Secret(pulumi.CustomResource):
                  value: Optional[pulumi.Input[str]] = None,
                  __props__=None):
         """
-        Create a Secret resource with the given unique name, props, and options.
+        Edge function secret of a project.
+
+        Existing secrets can be imported with their project reference and name:
+        `pulumi import supabase:index:Secret my-secret <projectRef>/<name>`
+
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
         :param pulumi.Input[str] name: Name of the secret
@@ -87,7 +91,11 @@ class Secret(pulumi.CustomResource):
                  args: SecretArgs,
                  opts: Optional[pulumi.ResourceOptions] = None):
         """
-        Create a Secret resource with the given unique name, props, and options.
+        Edge function secret of a project.
+
+        Existing secrets can be imported with their project reference and name:
+        `pulumi import supabase:index:Secret my-secret <projectRef>/<name>`
+
         :param str resource_name: The name of the resource.
         :param SecretArgs args: The arguments to use to populate this resource's properties.
         :param pulumi.ResourceOptions opts: Options for the resource.
@@ -129,7 +137,8 @@ class Secret(pulumi.CustomResource):
             if value is None and not opts.urn:
                 raise TypeError("Missing required property 'value'")
             __props__.__dict__["value"] = None if value is None else pulumi.Output.secret(value)
-        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["value"])
+            __props__.__dict__["digest"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["digest", "value"])
         opts = pulumi.ResourceOptions.merge(opts, secret_opts)
         super(Secret, __self__).__init__(
             'supabase:index:Secret',
@@ -153,10 +162,20 @@ class Secret(pulumi.CustomResource):
 
         __props__ = SecretArgs.__new__(SecretArgs)
 
+        __props__.__dict__["digest"] = None
         __props__.__dict__["name"] = None
+        __props__.__dict__["project_id"] = None
         __props__.__dict__["value"] = None
         return Secret(resource_name, opts=opts, __props__=__props__)
 
+    @property
+    @pulumi.getter
+    def digest(self) -> pulumi.Output[str]:
+        """
+        SHA-256 digest of the deployed value, used to detect changes made outside of Pulumi
+        """
+        return pulumi.get(self, "digest")
+
     @property
     @pulumi.getter
     def name(self) -> pulumi.Output[str]:
@@ -165,6 +184,14 @@ class Secret(pulumi.CustomResource):
         """
         return pulumi.get(self, "name")
 
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Output[Optional[str]]:
+        """
+        ID of the project
+        """
+        return pulumi.get(self, "project_id")
+
     @property
     @pulumi.getter
     def value(self) -> pulumi.Output[str]:
@@ -0,0 +1,205 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = ['SecretSetArgs', 'SecretSet']
+
+@pulumi.input_type
+class SecretSetArgs:
+    def __init__(__self__, *,
+                 project_id: pulumi.Input[str],
+                 secrets: pulumi.Input[Mapping[str, pulumi.Input[str]]],
+                 prune: Optional[pulumi.Input[bool]] = None):
+        """
+        The set of arguments for constructing a SecretSet resource.
+        :param pulumi.Input[str] project_id: ID of the project
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] secrets: Secret values by name
+        :param pulumi.Input[bool] prune: Delete the secrets of the project missing from `secrets` (secrets prefixed with SUPABASE_ are kept)
+        """
+        pulumi.set(__self__, "project_id", project_id)
+        pulumi.set(__self__, "secrets", secrets)
+        if prune is None:
+            prune = False
+        if prune is not None:
+            pulumi.set(__self__, "prune", prune)
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Input[str]:
+        """
+        ID of the project
+        """
+        return pulumi.get(self, "project_id")
+
+    @project_id.setter
+    def project_id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "project_id", value)
+
+    @property
+    @pulumi.getter
+    def secrets(self) -> pulumi.Input[Mapping[str, pulumi.Input[str]]]:
+        """
+        Secret values by name
+        """
+        return pulumi.get(self, "secrets")
+
+    @secrets.setter
+    def secrets(self, value: pulumi.Input[Mapping[str, pulumi.Input[str]]]):
+        pulumi.set(self, "secrets", value)
+
+    @property
+    @pulumi.getter
+    def prune(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Delete the secrets of the project missing from `secrets` (secrets prefixed with SUPABASE_ are kept)
+        """
+        return pulumi.get(self, "prune")
+
+    @prune.setter
+    def prune(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "prune", value)
+
+
+class SecretSet(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 prune: Optional[pulumi.Input[bool]] = None,
+                 secrets: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 __props__=None):
+        """
+        All the edge function secrets of a project managed together, every change is applied in a single API call.
+
+        Existing secrets can be adopted with the project reference: `pulumi import supabase:index:SecretSet secrets <projectRef>`,
+        their values are not readable so they are written again on the next update.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] project_id: ID of the project
+        :param pulumi.Input[bool] prune: Delete the secrets of the project missing from `secrets` (secrets prefixed with SUPABASE_ are kept)
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] secrets: Secret values by name
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: SecretSetArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        All the edge function secrets of a project managed together, every change is applied in a single API call.
+
+        Existing secrets can be adopted with the project reference: `pulumi import supabase:index:SecretSet secrets <projectRef>`,
+        their values are not readable so they are written again on the next update.
+
+        :param str resource_name: The name of the resource.
+        :param SecretSetArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(SecretSetArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 prune: Optional[pulumi.Input[bool]] = None,
+                 secrets: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 __props__=None):
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.plugin_download_url is None:
+            opts.plugin_download_url = _utilities.get_plugin_download_url()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = SecretSetArgs.__new__(SecretSetArgs)
+
+            if project_id is None and not opts.urn:
+                raise TypeError("Missing required property 'project_id'")
+            __props__.__dict__["project_id"] = project_id
+            if prune is None:
+                prune = False
+            __props__.__dict__["prune"] = prune
+            if secrets is None and not opts.urn:
+                raise TypeError("Missing required property 'secrets'")
+            __props__.__dict__["secrets"] = None if secrets is None else pulumi.Output.secret(secrets)
+            __props__.__dict__["digests"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["digests", "secrets"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(SecretSet, __self__).__init__(
+            'supabase:index:SecretSet',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'SecretSet':
+        """
+        Get an existing SecretSet resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = SecretSetArgs.__new__(SecretSetArgs)
+
+        __props__.__dict__["digests"] = None
+        __props__.__dict__["project_id"] = None
+        __props__.__dict__["prune"] = None
+        __props__.__dict__["secrets"] = None
+        return SecretSet(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def digests(self) -> pulumi.Output[Mapping[str, str]]:
+        """
+        SHA-256 digest of every deployed value, used to detect changes made outside of Pulumi
+        """
+        return pulumi.get(self, "digests")
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Output[str]:
+        """
+        ID of the project
+        """
+        return pulumi.get(self, "project_id")
+
+    @property
+    @pulumi.getter
+    def prune(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Delete the secrets of the project missing from `secrets`
+        """
+        return pulumi.get(self, "prune")
+
+    @property
+    @pulumi.getter
+    def secrets(self) -> pulumi.Output[Mapping[str, str]]:
+        """
+        Secret values by name
+        """
+        return pulumi.get(self, "secrets")
+
@@ -0,0 +1,173 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = ['SslEnforcementArgs', 'SslEnforcement']
+
+@pulumi.input_type
+class SslEnforcementArgs:
+    def __init__(__self__, *,
+                 project_id: pulumi.Input[str],
+                 database: Optional[pulumi.Input[bool]] = None):
+        """
+        The set of arguments for constructing a SslEnforcement resource.
+        :param pulumi.Input[str] project_id: ID of the project
+        :param pulumi.Input[bool] database: Whether connections to the database must use SSL
+        """
+        pulumi.set(__self__, "project_id", project_id)
+        if database is None:
+            database = True
+        if database is not None:
+            pulumi.set(__self__, "database", database)
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Input[str]:
+        """
+        ID of the project
+        """
+        return pulumi.get(self, "project_id")
+
+    @project_id.setter
+    def project_id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "project_id", value)
+
+    @property
+    @pulumi.getter
+    def database(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether connections to the database must use SSL
+        """
+        return pulumi.get(self, "database")
+
+    @database.setter
+    def database(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "database", value)
+
+
+class SslEnforcement(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 database: Optional[pulumi.Input[bool]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        SSL enforcement of the database of a project. Deleting the resource stops enforcing SSL, as on a new project.
+
+        The enforcement of a project can be imported with its reference:
+        `pulumi import supabase:index:SslEnforcement ssl <projectRef>`
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[bool] database: Whether connections to the database must use SSL
+        :param pulumi.Input[str] project_id: ID of the project
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: SslEnforcementArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        SSL enforcement of the database of a project. Deleting the resource stops enforcing SSL, as on a new project.
+
+        The enforcement of a project can be imported with its reference:
+        `pulumi import supabase:index:SslEnforcement ssl <projectRef>`
+
+        :param str resource_name: The name of the resource.
+        :param SslEnforcementArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(SslEnforcementArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 database: Optional[pulumi.Input[bool]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.plugin_download_url is None:
+            opts.plugin_download_url = _utilities.get_plugin_download_url()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = SslEnforcementArgs.__new__(SslEnforcementArgs)
+
+            if database is None:
+                database = True
+            __props__.__dict__["database"] = database
+            if project_id is None and not opts.urn:
+                raise TypeError("Missing required property 'project_id'")
+            __props__.__dict__["project_id"] = project_id
+            __props__.__dict__["applied_successfully"] = None
+        super(SslEnforcement, __self__).__init__(
+            'supabase:index:SslEnforcement',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'SslEnforcement':
+        """
+        Get an existing SslEnforcement resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = SslEnforcementArgs.__new__(SslEnforcementArgs)
+
+        __props__.__dict__["applied_successfully"] = None
+        __props__.__dict__["database"] = None
+        __props__.__dict__["project_id"] = None
+        return SslEnforcement(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="appliedSuccessfully")
+    def applied_successfully(self) -> pulumi.Output[bool]:
+        """
+        Whether the requested enforcement was applied to the database
+        """
+        return pulumi.get(self, "applied_successfully")
+
+    @property
+    @pulumi.getter
+    def database(self) -> pulumi.Output[bool]:
+        """
+        Whether connections to the database must use SSL
+        """
+        return pulumi.get(self, "database")
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Output[str]:
+        """
+        ID of the project
+        """
+        return pulumi.get(self, "project_id")
+
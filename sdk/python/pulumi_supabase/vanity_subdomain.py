@@ -0,0 +1,182 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = ['VanitySubdomainArgs', 'VanitySubdomain']
+
+@pulumi.input_type
+class VanitySubdomainArgs:
+    def __init__(__self__, *,
+                 project_id: pulumi.Input[str],
+                 subdomain: pulumi.Input[str]):
+        """
+        The set of arguments for constructing a VanitySubdomain resource.
+        :param pulumi.Input[str] project_id: ID of the project
+        :param pulumi.Input[str] subdomain: Vanity subdomain of the project, served as <subdomain>.supabase.co
+        """
+        pulumi.set(__self__, "project_id", project_id)
+        pulumi.set(__self__, "subdomain", subdomain)
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Input[str]:
+        """
+        ID of the project
+        """
+        return pulumi.get(self, "project_id")
+
+    @project_id.setter
+    def project_id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "project_id", value)
+
+    @property
+    @pulumi.getter
+    def subdomain(self) -> pulumi.Input[str]:
+        """
+        Vanity subdomain of the project, served as <subdomain>.supabase.co
+        """
+        return pulumi.get(self, "subdomain")
+
+    @subdomain.setter
+    def subdomain(self, value: pulumi.Input[str]):
+        pulumi.set(self, "subdomain", value)
+
+
+class VanitySubdomain(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 subdomain: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        Vanity subdomain of a project. Its availability is checked during the preview, and deleting the resource
+        removes the subdomain.
+
+        The vanity subdomain of a project can be imported with its reference:
+        `pulumi import supabase:index:VanitySubdomain subdomain <projectRef>`
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] project_id: ID of the project
+        :param pulumi.Input[str] subdomain: Vanity subdomain of the project, served as <subdomain>.supabase.co
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: VanitySubdomainArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Vanity subdomain of a project. Its availability is checked during the preview, and deleting the resource
+        removes the subdomain.
+
+        The vanity subdomain of a project can be imported with its reference:
+        `pulumi import supabase:index:VanitySubdomain subdomain <projectRef>`
+
+        :param str resource_name: The name of the resource.
+        :param VanitySubdomainArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(VanitySubdomainArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 subdomain: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.plugin_download_url is None:
+            opts.plugin_download_url = _utilities.get_plugin_download_url()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = VanitySubdomainArgs.__new__(VanitySubdomainArgs)
+
+            if project_id is None and not opts.urn:
+                raise TypeError("Missing required property 'project_id'")
+            __props__.__dict__["project_id"] = project_id
+            if subdomain is None and not opts.urn:
+                raise TypeError("Missing required property 'subdomain'")
+            __props__.__dict__["subdomain"] = subdomain
+            __props__.__dict__["custom_domain"] = None
+            __props__.__dict__["status"] = None
+        super(VanitySubdomain, __self__).__init__(
+            'supabase:index:VanitySubdomain',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'VanitySubdomain':
+        """
+        Get an existing VanitySubdomain resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = VanitySubdomainArgs.__new__(VanitySubdomainArgs)
+
+        __props__.__dict__["custom_domain"] = None
+        __props__.__dict__["project_id"] = None
+        __props__.__dict__["status"] = None
+        __props__.__dict__["subdomain"] = None
+        return VanitySubdomain(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="customDomain")
+    def custom_domain(self) -> pulumi.Output[str]:
+        """
+        Domain serving the project, <subdomain>.supabase.co
+        """
+        return pulumi.get(self, "custom_domain")
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Output[str]:
+        """
+        ID of the project
+        """
+        return pulumi.get(self, "project_id")
+
+    @property
+    @pulumi.getter
+    def status(self) -> pulumi.Output[str]:
+        """
+        Status of the subdomain (active, custom-domain-used or not-used)
+        """
+        return pulumi.get(self, "status")
+
+    @property
+    @pulumi.getter
+    def subdomain(self) -> pulumi.Output[str]:
+        """
+        Vanity subdomain of the project, served as <subdomain>.supabase.co
+        """
+        return pulumi.get(self, "subdomain")
+